@@ -0,0 +1,91 @@
+package gopheragent_test
+
+import (
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+type botTestCase struct {
+	UA,
+	BotName,
+	BotCategory string
+}
+
+func Test_UserAgent_Bot(t *testing.T) {
+
+	tests := []botTestCase{
+		{
+			UA:          "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			BotName:     "Googlebot",
+			BotCategory: gopheragent.BotSearchEngine,
+		},
+		{
+			UA:          "Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Googlebot/2.1; +http://www.google.com/bot.html) Chrome/120.0.6099.224 Safari/537.36",
+			BotName:     "Googlebot",
+			BotCategory: gopheragent.BotSearchEngine,
+		},
+		{
+			UA:          "Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)",
+			BotName:     "Bingbot",
+			BotCategory: gopheragent.BotSearchEngine,
+		},
+		{
+			UA:          "Mozilla/5.0 (compatible; AhrefsBot/7.0; +http://ahrefs.com/robot/)",
+			BotName:     "AhrefsBot",
+			BotCategory: gopheragent.BotSEOCrawler,
+		},
+		{
+			UA:          "facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+			BotName:     "facebookexternalhit",
+			BotCategory: gopheragent.BotLinkPreviewer,
+		},
+		{
+			UA:          "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)",
+			BotName:     "Slackbot",
+			BotCategory: gopheragent.BotLinkPreviewer,
+		},
+		{
+			UA:          "Mozilla/5.0 (compatible; UptimeRobot/2.0; http://www.uptimerobot.com/)",
+			BotName:     "UptimeRobot",
+			BotCategory: gopheragent.BotMonitoring,
+		},
+		{
+			UA:          "Feedly/1.0 (+http://www.feedly.com/fetcher.html; 16 subscribers; like FeedFetcher-Google)",
+			BotName:     "Feedly",
+			BotCategory: gopheragent.BotFeedFetcher,
+		},
+		{
+			UA:          "Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.2; +https://openai.com/gptbot)",
+			BotName:     "GPTBot",
+			BotCategory: gopheragent.BotAICrawler,
+		},
+		{
+			UA:          "Mozilla/5.0 (compatible; SomeNewCrawler/0.1)",
+			BotName:     gopheragent.GenericBot,
+			BotCategory: gopheragent.BotGeneric,
+		},
+		{
+			UA: "Mozilla/5.0 (Linux; Android 4.4.2; CUBOT NOTE S Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
+		},
+		{
+			UA: "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.New(test.UA)
+
+		if got := ua.IsBot(); got != (test.BotName != "") {
+			t.Errorf("UserAgent.IsBot[%s] => %t; want %t", test.UA, got, test.BotName != "")
+		}
+
+		if got := ua.BotName(); got != test.BotName {
+			t.Errorf("UserAgent.BotName[%s] => %s; want %s", test.UA, got, test.BotName)
+		}
+
+		if got := ua.BotCategory(); got != test.BotCategory {
+			t.Errorf("UserAgent.BotCategory[%s] => %s; want %s", test.UA, got, test.BotCategory)
+		}
+	}
+}
@@ -0,0 +1,657 @@
+package gopheragent
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BrowserslistData holds the browser versions, release dates and global usage
+// browserslist queries are evaluated against. The bundled snapshot is compact
+// and approximate: load the caniuse data used by the build to evaluate queries
+// exactly as it does.
+type BrowserslistData struct {
+	agents map[string][]browserslistVersion
+	names  []string
+	esr    []string
+}
+
+type browserslistVersion struct {
+	Version     string  `json:"version"`
+	GlobalUsage float64 `json:"global_usage"`
+	ReleaseDate *int64  `json:"release_date"`
+}
+
+type browserslistSpec struct {
+	Agents map[string]struct {
+		VersionList []browserslistVersion `json:"version_list"`
+	} `json:"agents"`
+	FirefoxESR []string `json:"firefox_esr"`
+}
+
+//go:embed browserslist.json
+var defaultBrowserslistJSON []byte
+
+var defaultBrowserslistData *BrowserslistData
+
+// browserslistAliases maps browserslist browser names onto caniuse agents
+var browserslistAliases = map[string]string{
+	"fx":             "firefox",
+	"ff":             "firefox",
+	"ios":            "ios_saf",
+	"explorer":       "ie",
+	"blackberry":     "bb",
+	"explorermobile": "ie_mob",
+	"operamini":      "op_mini",
+	"operamobile":    "op_mob",
+	"chromeandroid":  "and_chr",
+	"firefoxandroid": "and_ff",
+	"ucandroid":      "and_uc",
+	"qqandroid":      "and_qq",
+}
+
+// browserslistDesktop maps mobile agents, whose usage data only lists their
+// latest version, onto the desktop agent sharing their versions
+var browserslistDesktop = map[string]string{
+	"and_chr": "chrome",
+	"and_ff":  "firefox",
+}
+
+// browserslistShorthands are the queries standing for other queries
+var browserslistShorthands = map[string]string{
+	"defaults": "> 0.5%, last 2 versions, Firefox ESR, not dead",
+	"dead":     "Baidu >= 0, ie <= 11, ie_mob <= 11, bb <= 10, op_mob <= 12.1, samsung 4",
+}
+
+// browserslistYear is the length of a year in seconds, as in browserslist
+const browserslistYear = 365.259641 * 24 * 60 * 60
+
+var (
+	browserslistOr  = regexp.MustCompile(`(?i),\s*|\s+or\s+`)
+	browserslistAnd = regexp.MustCompile(`(?i)\s+and\s+`)
+)
+
+type browserslistSelection map[string]bool
+
+type browserslistQuery struct {
+	pattern *regexp.Regexp
+	sel     func(data *BrowserslistData, m []string) (browserslistSelection, error)
+}
+
+// browserslistQueries are the supported queries, tried in order
+var browserslistQueries = []browserslistQuery{
+	{
+		regexp.MustCompile(`(?i)^last\s+(\d+)\s+major\s+versions?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			return data.lastMajors(data.names, atoi(m[1])), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^last\s+(\d+)\s+versions?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			return data.last(data.names, atoi(m[1])), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^last\s+(\d+)\s+(\w+)\s+major\s+versions?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			agent, err := data.agent(m[2])
+			if err != nil {
+				return nil, err
+			}
+
+			return data.lastMajors([]string{agent}, atoi(m[1])), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^last\s+(\d+)\s+(\w+)\s+versions?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			agent, err := data.agent(m[2])
+			if err != nil {
+				return nil, err
+			}
+
+			return data.last([]string{agent}, atoi(m[1])), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^unreleased\s+versions$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			return data.filter(data.names, func(v browserslistVersion) bool {
+				return v.ReleaseDate == nil
+			}), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^unreleased\s+(\w+)\s+versions?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			agent, err := data.agent(m[1])
+			if err != nil {
+				return nil, err
+			}
+
+			return data.filter([]string{agent}, func(v browserslistVersion) bool {
+				return v.ReleaseDate == nil
+			}), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^last\s+(\d*\.?\d+)\s+years?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			years, _ := strconv.ParseFloat(m[1], 64)
+			return data.since(time.Now().Add(-time.Duration(years * browserslistYear * float64(time.Second)))), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^since\s+(\d+)(?:-(\d+))?(?:-(\d+))?$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			month, day := 1, 1
+			if m[2] != "" {
+				month = atoi(m[2])
+			}
+
+			if m[3] != "" {
+				day = atoi(m[3])
+			}
+
+			return data.since(time.Date(atoi(m[1]), time.Month(month), day, 0, 0, 0, 0, time.UTC)), nil
+		},
+	},
+	{
+		regexp.MustCompile(`^(>=?|<=?)\s*(\d*\.?\d+)%$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			usage, _ := strconv.ParseFloat(m[2], 64)
+
+			return data.filter(data.names, func(v browserslistVersion) bool {
+				cmp := 0
+				if v.GlobalUsage > usage {
+					cmp = 1
+				} else if v.GlobalUsage < usage {
+					cmp = -1
+				}
+
+				return compareOp(m[1], cmp)
+			}), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^(?:firefox|ff|fx)\s+esr$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			sel := browserslistSelection{}
+			for _, v := range data.esr {
+				sel["firefox "+v] = true
+			}
+
+			return sel, nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^(\w+)\s+([\d.]+)\s*-\s*([\d.]+)$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			agent, err := data.agent(m[1])
+			if err != nil {
+				return nil, err
+			}
+
+			from, to := ParseVersion(m[2]), ParseVersion(m[3])
+
+			return data.filter([]string{agent}, func(v browserslistVersion) bool {
+				first := firstVersion(v.Version)
+				return v.ReleaseDate != nil && first.Compare(from) >= 0 && first.Compare(to) <= 0
+			}), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^(\w+)\s*(>=?|<=?)\s*([\d.]+)$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			agent, err := data.agent(m[1])
+			if err != nil {
+				return nil, err
+			}
+
+			version := ParseVersion(m[3])
+
+			return data.filter([]string{agent}, func(v browserslistVersion) bool {
+				return v.ReleaseDate != nil && compareOp(m[2], firstVersion(v.Version).Compare(version))
+			}), nil
+		},
+	},
+	{
+		regexp.MustCompile(`(?i)^(\w+)\s+(all|tp|[\d.]+)$`),
+		func(data *BrowserslistData, m []string) (browserslistSelection, error) {
+			agent, err := data.agent(m[1])
+			if err != nil {
+				return nil, err
+			}
+
+			sel := data.filter([]string{agent}, func(v browserslistVersion) bool {
+				return strings.EqualFold(v.Version, m[2]) || browserslistMatch(v.Version, ParseVersion(m[2]))
+			})
+			if len(sel) == 0 {
+				return nil, fmt.Errorf("gopheragent: browserslist: unknown version %s of %s", m[2], m[1])
+			}
+
+			return sel, nil
+		},
+	},
+}
+
+// LoadBrowserslistData reads browserslist data in the format of the agents of
+// caniuse's data-2.0.json, e.g.
+//
+//	{"agents": {"chrome": {"version_list": [{"version": "120", "global_usage": 0.5, "release_date": 1701993600}]}}}
+//
+// The Firefox ESR versions may be given as "firefox_esr" and otherwise default
+// to the bundled ones.
+func LoadBrowserslistData(r io.Reader) (*BrowserslistData, error) {
+
+	var spec browserslistSpec
+
+	if err := json.NewDecoder(r).Decode(&spec); err != nil {
+		return nil, err
+	}
+
+	if len(spec.Agents) == 0 {
+		return nil, fmt.Errorf("gopheragent: browserslist: no agents")
+	}
+
+	data := BrowserslistData{
+		agents: map[string][]browserslistVersion{},
+		esr:    spec.FirefoxESR,
+	}
+
+	if data.esr == nil && defaultBrowserslistData != nil {
+		data.esr = defaultBrowserslistData.esr
+	}
+
+	for name, agent := range spec.Agents {
+		data.agents[name] = agent.VersionList
+		data.names = append(data.names, name)
+	}
+
+	sort.Strings(data.names)
+
+	return &data, nil
+}
+
+// Browserslist is a compiled browserslist query, e.g. "> 0.5%, last 2
+// versions, not dead". Queries combine with ",", "or", "and" and "not" as in
+// browserslist, which supports more queries than are implemented here.
+type Browserslist struct {
+	data     *BrowserslistData
+	selected map[string][]string
+}
+
+// NewBrowserslist compiles a browserslist query against the bundled data
+func NewBrowserslist(query string) (*Browserslist, error) {
+	return defaultBrowserslistData.Query(query)
+}
+
+// Query compiles a browserslist query against the data
+func (data *BrowserslistData) Query(query string) (*Browserslist, error) {
+
+	sel, err := data.eval(query)
+	if err != nil {
+		return nil, err
+	}
+
+	b := Browserslist{
+		data:     data,
+		selected: map[string][]string{},
+	}
+
+	for _, name := range data.names {
+		for _, v := range data.agents[name] {
+			if sel[name+" "+v.Version] {
+				b.selected[name] = append(b.selected[name], v.Version)
+			}
+		}
+	}
+
+	return &b, nil
+}
+
+// Browsers returns the selected browsers as browserslist prints them, e.g.
+// "chrome 120", by name and newest version first
+func (b *Browserslist) Browsers() []string {
+
+	var browsers []string
+
+	for _, name := range b.data.names {
+		versions := b.selected[name]
+
+		for i := len(versions) - 1; i >= 0; i-- {
+			browsers = append(browsers, name+" "+versions[i])
+		}
+	}
+
+	return browsers
+}
+
+// Match returns true if the browser of the user agent is selected by the
+// query. Browsers on iOS are matched as Safari for iOS by their OS version,
+// and Chrome and Firefox for Android by their desktop versions as well.
+func (b *Browserslist) Match(ua *UserAgent) bool {
+
+	agent, version := browserslistAgent(ua)
+	if agent == "" || version == "" {
+		return false
+	}
+
+	v := ParseVersion(version)
+
+	for _, name := range []string{agent, browserslistDesktop[agent]} {
+		for _, selected := range b.selected[name] {
+			if browserslistMatch(selected, v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// browserslistAgent returns the caniuse agent and version of the browser of
+// the user agent
+func browserslistAgent(ua *UserAgent) (string, string) {
+
+	platform := ua.Platform()
+	android := platform == Android
+
+	switch platform {
+	case Iphone, Ipad, Ipod:
+		// every iOS browser uses the Safari engine
+		if version := ua.OSVersion(); version != "" || ua.BrowserName() != Safari {
+			return "ios_saf", version
+		}
+
+		return "ios_saf", ua.BrowserVersion()
+	}
+
+	switch browser := ua.BrowserName(); browser {
+	case Chrome, Brave, Vivaldi, Yandex:
+		version := ua.BrowserVersion()
+		if browser != Chrome {
+			version = firstSubmatch(ua.rules.browserVersions[Chrome], ua.s)
+		}
+
+		if android {
+			return "and_chr", version
+		}
+
+		return "chrome", version
+
+	case Firefox:
+		if android {
+			return "and_ff", ua.BrowserVersion()
+		}
+
+		return "firefox", ua.BrowserVersion()
+
+	case Opera:
+		if android {
+			return "op_mob", ua.BrowserVersion()
+		}
+
+		return "opera", ua.BrowserVersion()
+
+	case Safari:
+		return "safari", ua.BrowserVersion()
+	case Edge, EdgeLegacy:
+		return "edge", ua.BrowserVersion()
+	case IE:
+		return "ie", ua.BrowserVersion()
+	case IEMobile:
+		return "ie_mob", ua.BrowserVersion()
+	case Samsung:
+		return "samsung", ua.BrowserVersion()
+	case UCBrowser:
+		return "and_uc", ua.BrowserVersion()
+	}
+
+	return "", ""
+}
+
+// browserslistMatch reports whether a version falls within a caniuse version,
+// such as "17.2" for 17.2.1 or "16.6-16.7" for 16.7.10
+func browserslistMatch(selected string, v Version) bool {
+
+	if selected == "all" {
+		return true
+	}
+
+	from, to := selected, selected
+	if i := strings.Index(selected, "-"); i >= 0 {
+		from, to = selected[:i], selected[i+1:]
+	}
+
+	if from == "" || from[0] < '0' || from[0] > '9' {
+		return false
+	}
+
+	// compare as many components as caniuse gives
+	v = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	switch strings.Count(from, ".") {
+	case 0:
+		v.Minor, v.Patch = 0, 0
+	case 1:
+		v.Patch = 0
+	}
+
+	return v.Compare(ParseVersion(from)) >= 0 && v.Compare(ParseVersion(to)) <= 0
+}
+
+// eval selects the browsers of a query, combining its parts left to right
+func (data *BrowserslistData) eval(query string) (browserslistSelection, error) {
+
+	result := browserslistSelection{}
+
+	for i, or := range browserslistOr.Split(strings.TrimSpace(query), -1) {
+		for j, part := range browserslistAnd.Split(or, -1) {
+			part = strings.TrimSpace(part)
+
+			not := len(part) > 4 && strings.EqualFold(part[:4], "not ")
+			if not {
+				if i == 0 && j == 0 {
+					return nil, fmt.Errorf("gopheragent: browserslist: %q cannot start with not", query)
+				}
+
+				part = strings.TrimSpace(part[4:])
+			}
+
+			sel, err := data.selectQuery(part)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case not:
+				for k := range sel {
+					delete(result, k)
+				}
+
+			case j > 0:
+				for k := range result {
+					if !sel[k] {
+						delete(result, k)
+					}
+				}
+
+			default:
+				for k := range sel {
+					result[k] = true
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (data *BrowserslistData) selectQuery(query string) (browserslistSelection, error) {
+
+	if shorthand, ok := browserslistShorthands[strings.ToLower(query)]; ok {
+		return data.eval(shorthand)
+	}
+
+	for _, q := range browserslistQueries {
+		if m := q.pattern.FindStringSubmatch(query); m != nil {
+			return q.sel(data, m)
+		}
+	}
+
+	return nil, fmt.Errorf("gopheragent: browserslist: unknown query %q", query)
+}
+
+// agent resolves a browserslist browser name
+func (data *BrowserslistData) agent(name string) (string, error) {
+
+	name = strings.ToLower(name)
+	if alias, ok := browserslistAliases[name]; ok {
+		name = alias
+	}
+
+	if _, ok := data.agents[name]; !ok {
+		return "", fmt.Errorf("gopheragent: browserslist: unknown browser %s", name)
+	}
+
+	return name, nil
+}
+
+func (data *BrowserslistData) filter(agents []string, keep func(browserslistVersion) bool) browserslistSelection {
+
+	sel := browserslistSelection{}
+
+	for _, name := range agents {
+		for _, v := range data.agents[name] {
+			if keep(v) {
+				sel[name+" "+v.Version] = true
+			}
+		}
+	}
+
+	return sel
+}
+
+// last selects the last n released versions of the agents
+func (data *BrowserslistData) last(agents []string, n int) browserslistSelection {
+
+	sel := browserslistSelection{}
+
+	for _, name := range agents {
+		released := data.released(name)
+		if len(released) > n {
+			released = released[len(released)-n:]
+		}
+
+		for _, v := range released {
+			sel[name+" "+v] = true
+		}
+	}
+
+	return sel
+}
+
+// lastMajors selects the released versions of the last n major versions of
+// the agents
+func (data *BrowserslistData) lastMajors(agents []string, n int) browserslistSelection {
+
+	sel := browserslistSelection{}
+
+	for _, name := range agents {
+		released := data.released(name)
+
+		var majors []int
+		for _, v := range released {
+			major := firstVersion(v).Major
+			if len(majors) == 0 || majors[len(majors)-1] != major {
+				majors = append(majors, major)
+			}
+		}
+
+		if len(majors) == 0 {
+			continue
+		}
+
+		min := majors[0]
+		if len(majors) > n {
+			min = majors[len(majors)-n]
+		}
+
+		for _, v := range released {
+			if firstVersion(v).Major >= min {
+				sel[name+" "+v] = true
+			}
+		}
+	}
+
+	return sel
+}
+
+// since selects the versions released at or after t
+func (data *BrowserslistData) since(t time.Time) browserslistSelection {
+
+	return data.filter(data.names, func(v browserslistVersion) bool {
+		return v.ReleaseDate != nil && *v.ReleaseDate >= t.Unix()
+	})
+}
+
+func (data *BrowserslistData) released(agent string) []string {
+
+	var released []string
+	for _, v := range data.agents[agent] {
+		if v.ReleaseDate != nil {
+			released = append(released, v.Version)
+		}
+	}
+
+	return released
+}
+
+// firstVersion parses the first version of a caniuse range such as "15.2-15.3"
+func firstVersion(s string) Version {
+
+	if i := strings.Index(s, "-"); i >= 0 {
+		s = s[:i]
+	}
+
+	return ParseVersion(s)
+}
+
+func compareOp(op string, cmp int) bool {
+
+	switch op {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+
+	return false
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func init() {
+
+	data, err := LoadBrowserslistData(bytes.NewReader(defaultBrowserslistJSON))
+	if err != nil {
+		panic(err)
+	}
+
+	defaultBrowserslistData = data
+}
@@ -0,0 +1,121 @@
+package gopheragent_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_NewBrowserslist(t *testing.T) {
+
+	tests := map[string][]string{
+		"firefox esr":                        {"firefox 128", "firefox 115"},
+		"chrome 100-102":                     {"chrome 102", "chrome 101", "chrome 100"},
+		"ios 15.2":                           {"ios_saf 15.2-15.3"},
+		"last 2 Safari versions":             {"safari 18.5", "safari 18.4"},
+		"safari >= 18.3 and not safari 18.4": {"safari 18.5", "safari 18.3"},
+		"ie >= 10 or Edge 12":                {"edge 12", "ie 11", "ie 10"},
+		"op_mini all":                        {"op_mini all"},
+		"dead": {
+			"baidu 13.52",
+			"bb 10", "bb 7",
+			"ie 11", "ie 10", "ie 9", "ie 8", "ie 7", "ie 6",
+			"ie_mob 11", "ie_mob 10",
+			"op_mob 12.1", "op_mob 12",
+			"samsung 4",
+		},
+	}
+
+	for query, want := range tests {
+		b, err := gopheragent.NewBrowserslist(query)
+		if err != nil {
+			t.Errorf("NewBrowserslist[%s] => %v", query, err)
+			continue
+		}
+
+		if got := b.Browsers(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Browserslist.Browsers[%s] => %v; want %v", query, got, want)
+		}
+	}
+}
+
+func Test_NewBrowserslist_Errors(t *testing.T) {
+
+	tests := []string{
+		"not dead",
+		"chrome 12.5",
+		"netscape > 1",
+		"supports es6-module",
+		"defaults,",
+	}
+
+	for _, query := range tests {
+		if _, err := gopheragent.NewBrowserslist(query); err == nil {
+			t.Errorf("NewBrowserslist[%s] => nil error; want error", query)
+		}
+	}
+}
+
+func Test_Browserslist_Match(t *testing.T) {
+
+	b, err := gopheragent.NewBrowserslist("defaults")
+	if err != nil {
+		t.Fatalf("NewBrowserslist => %v", err)
+	}
+
+	tests := map[string]bool{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/137.0.0.0 Safari/537.36":                                    true,
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36":                                    false,
+		"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Mobile Safari/537.36":                                    true,
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Brave Chrome/137.0.0.0 Safari/537.36":                              true,
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/137.0.0.0 Safari/537.36 Edg/137.0.3296.52":                  true,
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:115.0) Gecko/20100101 Firefox/115.0":                                                                   true,
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 18_4_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.4 Mobile/15E148 Safari/604.1":          true,
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 16_7_10 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/137.0.7151.79 Mobile/15E148 Safari/604.1":  true,
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 15_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.4 Mobile/15E148 Safari/604.1":            false,
+		"Mozilla/5.0 (Linux; Android 14; SAMSUNG SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/28.0 Chrome/130.0.0.0 Mobile Safari/537.36": true,
+		"Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko":                                                                               false,
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)":                                                                           false,
+	}
+
+	for ua, want := range tests {
+		if got := b.Match(gopheragent.New(ua)); got != want {
+			t.Errorf("Browserslist.Match[%s] => %t; want %t", ua, got, want)
+		}
+	}
+}
+
+func Test_LoadBrowserslistData(t *testing.T) {
+
+	data, err := gopheragent.LoadBrowserslistData(strings.NewReader(`{"agents": {
+		"chrome": {"version_list": [
+			{"version": "89", "global_usage": 0.2, "release_date": 1614643200},
+			{"version": "90", "global_usage": 1.5, "release_date": 1618272000},
+			{"version": "91", "global_usage": 0, "release_date": null}
+		]}
+	}}`))
+	if err != nil {
+		t.Fatalf("LoadBrowserslistData => %v", err)
+	}
+
+	tests := map[string][]string{
+		"last 1 version":      {"chrome 90"},
+		"> 1%":                {"chrome 90"},
+		"since 2021-03-01":    {"chrome 90", "chrome 89"},
+		"unreleased versions": {"chrome 91"},
+	}
+
+	for query, want := range tests {
+		b, err := data.Query(query)
+		if err != nil {
+			t.Errorf("BrowserslistData.Query[%s] => %v", query, err)
+			continue
+		}
+
+		if got := b.Browsers(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Browserslist.Browsers[%s] => %v; want %v", query, got, want)
+		}
+	}
+}
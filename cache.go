@@ -0,0 +1,123 @@
+package gopheragent
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a size-bounded, least recently used cache of parsed user agents
+// keyed by the raw UA string and client hints. It is safe for concurrent use.
+// A Cache should only be used by a single Parser, since cached results depend
+// on its rules.
+type Cache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  *list.List
+	items    map[string]*list.Element
+	hits,
+	misses uint64
+}
+
+// CacheStats reports the activity of a Cache
+type CacheStats struct {
+	Hits,
+	Misses uint64
+	Size int
+}
+
+type cacheEntry struct {
+	key     string
+	ua      *UserAgent
+	expires time.Time
+}
+
+// NewCache returns a Cache holding up to capacity user agents. When ttl is
+// positive, entries older than ttl are parsed again.
+func NewCache(capacity int, ttl time.Duration) *Cache {
+
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &Cache{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  list.New(),
+		items:    make(map[string]*list.Element, capacity),
+	}
+}
+
+// WithCache puts the cache in front of the parser
+func WithCache(c *Cache) Option {
+	return func(p *Parser) {
+		p.cache = c
+	}
+}
+
+// Stats returns the hit and miss counters and the current size of the cache
+func (c *Cache) Stats() CacheStats {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{
+		Hits:   c.hits,
+		Misses: c.misses,
+		Size:   c.entries.Len(),
+	}
+}
+
+func (c *Cache) get(key string) (*UserAgent, bool) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*cacheEntry)
+
+		if c.ttl <= 0 || time.Now().Before(entry.expires) {
+			c.entries.MoveToFront(el)
+			c.hits++
+			return entry.ua, true
+		}
+
+		c.remove(el)
+	}
+
+	c.misses++
+	return nil, false
+}
+
+func (c *Cache) add(key string, ua *UserAgent) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{
+		key: key,
+		ua:  ua,
+	}
+
+	if c.ttl > 0 {
+		entry.expires = time.Now().Add(c.ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		el.Value = entry
+		c.entries.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.entries.PushFront(entry)
+
+	for c.entries.Len() > c.capacity {
+		c.remove(c.entries.Back())
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.entries.Remove(el)
+	delete(c.items, el.Value.(*cacheEntry).key)
+}
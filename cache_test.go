@@ -0,0 +1,119 @@
+package gopheragent_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_Cache(t *testing.T) {
+
+	cache := gopheragent.NewCache(2, 0)
+	parser := gopheragent.NewParser(gopheragent.WithCache(cache))
+
+	a := parser.New(testCases[0].UA)
+	if b := parser.New(testCases[0].UA); a != b {
+		t.Errorf("Parser.New => %p; want cached %p", b, a)
+	}
+
+	parser.New(testCases[1].UA)
+	parser.New(testCases[2].UA)
+
+	// the first user agent was evicted
+	if b := parser.New(testCases[0].UA); a == b {
+		t.Errorf("Parser.New => %p; want a new UserAgent", b)
+	}
+
+	want := gopheragent.CacheStats{Hits: 1, Misses: 4, Size: 2}
+	if got := cache.Stats(); got != want {
+		t.Errorf("Cache.Stats => %+v; want %+v", got, want)
+	}
+
+	if parser.Cache() != cache {
+		t.Errorf("Parser.Cache => %p; want %p", parser.Cache(), cache)
+	}
+}
+
+func Test_Cache_TTL(t *testing.T) {
+
+	cache := gopheragent.NewCache(10, 10*time.Millisecond)
+	parser := gopheragent.NewParser(gopheragent.WithCache(cache))
+
+	a := parser.New(testCases[0].UA)
+	time.Sleep(20 * time.Millisecond)
+
+	if b := parser.New(testCases[0].UA); a == b {
+		t.Errorf("Parser.New => %p; want an expired entry to be parsed again", b)
+	}
+
+	if got := cache.Stats(); got.Hits != 0 || got.Misses != 2 {
+		t.Errorf("Cache.Stats => %+v; want 0 hits and 2 misses", got)
+	}
+}
+
+func Test_Cache_Concurrent(t *testing.T) {
+
+	parser := gopheragent.NewParser(gopheragent.WithCache(gopheragent.NewCache(16, 0)))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for _, test := range testCases[:64] {
+				if got := parser.New(test.UA).BrowserName(); got != test.BrowserName {
+					t.Errorf("UserAgent.BrowserName[%s] => %s; want %s", test.UA, got, test.BrowserName)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func Test_SetDefaultParser(t *testing.T) {
+
+	defaults := gopheragent.DefaultParser()
+	defer gopheragent.SetDefaultParser(defaults)
+
+	cache := gopheragent.NewCache(10, 0)
+	gopheragent.SetDefaultParser(gopheragent.NewParser(gopheragent.WithCache(cache)))
+
+	gopheragent.New(testCases[0].UA)
+	gopheragent.Parse(testCases[0].UA)
+
+	want := gopheragent.CacheStats{Hits: 1, Misses: 1, Size: 1}
+	if got := cache.Stats(); got != want {
+		t.Errorf("Cache.Stats => %+v; want %+v", got, want)
+	}
+}
+
+func Test_SetRules_Cache(t *testing.T) {
+
+	defaults := gopheragent.DefaultParser()
+	defer gopheragent.SetDefaultParser(defaults)
+
+	gopheragent.SetDefaultParser(gopheragent.NewParser(gopheragent.WithCache(gopheragent.NewCache(2, 0))))
+	gopheragent.New(testCases[0].UA)
+
+	gopheragent.SetRules(gopheragent.DefaultRules())
+
+	cache := gopheragent.DefaultParser().Cache()
+	if cache == nil {
+		t.Fatal("Parser.Cache => nil; want a cache")
+	}
+
+	// user agents parsed with the previous rules are dropped
+	if got := cache.Stats(); got != (gopheragent.CacheStats{}) {
+		t.Errorf("Cache.Stats => %+v; want an empty cache", got)
+	}
+
+	if a, b := gopheragent.New(testCases[0].UA), gopheragent.New(testCases[0].UA); a != b {
+		t.Errorf("New => %p; want cached %p", b, a)
+	}
+}
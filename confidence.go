@@ -0,0 +1,99 @@
+package gopheragent
+
+import "strings"
+
+// Confidence tells how far a detail of a user agent can be trusted
+type Confidence string
+
+// Confidences
+const (
+	// ConfidenceExact details are given as is by the UA string or the client
+	// hints
+	ConfidenceExact Confidence = "exact"
+
+	// ConfidenceFrozen details are placeholders of a reduced or frozen UA
+	// string, such as Chrome 124.0.0.0 or Android 10 on model K
+	ConfidenceFrozen Confidence = "frozen"
+
+	// ConfidenceInferred details are guessed, such as the iPadOS of an iPad in
+	// desktop mode
+	ConfidenceInferred Confidence = "inferred"
+
+	// ConfidenceUnknown details were not found
+	ConfidenceUnknown Confidence = "unknown"
+)
+
+// Confidences holds the confidence of the details of a user agent
+type Confidences struct {
+	BrowserVersion Confidence `json:"browser_version"`
+	OS             Confidence `json:"os"`
+	Platform       Confidence `json:"platform"`
+	Model          Confidence `json:"model"`
+}
+
+// Reduced returns true if the user agent is a reduced UA string, as sent by
+// Chrome, whose minor version, OS version and device model are frozen to
+// placeholders such as "Android 10; K" or "Windows NT 10.0; Win64; x64"
+func (ua *UserAgent) Reduced() bool {
+	return matchFirst(ua.rules.reduced, ua.s, ua.keywords()) != ""
+}
+
+// Model returns the device model from the Sec-CH-UA-Model hint or else the UA
+// string, e.g. "Pixel 7", or an empty string if it is not mentioned
+func (ua *UserAgent) Model() string {
+
+	ua.modelOnce.Do(func() {
+		if ua.model = ua.hints.Model; ua.model == "" {
+			ua.model = matchFirst(ua.rules.models, ua.s, ua.keywords())
+		}
+	})
+
+	return ua.model
+
+}
+
+// Confidence returns how far the browser version, OS, platform and model of
+// the user agent can be trusted
+func (ua *UserAgent) Confidence() Confidences {
+
+	reduced := ua.Reduced()
+
+	c := Confidences{
+		BrowserVersion: ConfidenceExact,
+		OS:             ConfidenceExact,
+		Platform:       ConfidenceExact,
+		Model:          ConfidenceExact,
+	}
+
+	switch version := ua.BrowserVersion(); {
+	case version == "":
+		c.BrowserVersion = ConfidenceUnknown
+	case reduced && !ua.browserRefined && strings.HasSuffix(version, ".0.0.0"):
+		c.BrowserVersion = ConfidenceFrozen
+	}
+
+	switch {
+	case strings.EqualFold(ua.OS(), Unknown):
+		c.OS = ConfidenceUnknown
+	case ua.platformInferred:
+		c.OS = ConfidenceInferred
+	case ua.OSFrozen():
+		c.OS = ConfidenceFrozen
+	}
+
+	switch {
+	case ua.Platform() == Unknown:
+		c.Platform = ConfidenceUnknown
+	case ua.platformInferred:
+		c.Platform = ConfidenceInferred
+	}
+
+	switch {
+	case ua.Model() == "":
+		c.Model = ConfidenceUnknown
+	case reduced && ua.hints.Model == "" && ua.Model() == "K":
+		c.Model = ConfidenceFrozen
+	}
+
+	return c
+}
@@ -0,0 +1,114 @@
+package gopheragent_test
+
+import (
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_UserAgent_Confidence(t *testing.T) {
+
+	const (
+		reduced = "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36"
+		exact   = gopheragent.ConfidenceExact
+		frozen  = gopheragent.ConfidenceFrozen
+		unknown = gopheragent.ConfidenceUnknown
+	)
+
+	tests := []struct {
+		UA string
+		gopheragent.Hints
+		Reduced bool
+		Model   string
+		gopheragent.Confidences
+	}{
+		{
+			UA:          reduced,
+			Reduced:     true,
+			Model:       "K",
+			Confidences: gopheragent.Confidences{BrowserVersion: frozen, OS: frozen, Platform: exact, Model: frozen},
+		},
+		{
+			UA: reduced,
+			Hints: gopheragent.Hints{
+				Platform:        "Android",
+				PlatformVersion: "14.0.0",
+				Mobile:          true,
+				Model:           "Pixel 8",
+				FullVersionList: []gopheragent.Brand{{Brand: "Google Chrome", Version: "124.0.6367.82"}},
+			},
+			Reduced:     true,
+			Model:       "Pixel 8",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Linux; Android 13; Pixel 7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/116.0.5845.92 Mobile Safari/537.36",
+			Model:       "Pixel 7",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Linux; Android 14; SAMSUNG SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/28.0 Chrome/130.0.0.0 Mobile Safari/537.36",
+			Model:       "SM-S918B",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Linux; Android 14; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Model:       "SM-X700",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Linux; Android 10; CUBOT_X19) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Mobile Safari/537.36",
+			Model:       "CUBOT_X19",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Linux; Android 10; moto g(7) play) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/83.0.4103.101 Mobile Safari/537.36",
+			Model:       "moto g(7) play",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Linux; U; Android 9; en-us; moto g(7) play Build/PCYS29.105-134-1) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
+			Model:       "moto g(7) play",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: exact},
+		},
+		{
+			UA:          "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+			Reduced:     true,
+			Confidences: gopheragent.Confidences{BrowserVersion: frozen, OS: frozen, Platform: exact, Model: unknown},
+		},
+		{
+			UA:          "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			Hints:       gopheragent.Hints{MaxTouchPoints: 5},
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: gopheragent.ConfidenceInferred, Platform: gopheragent.ConfidenceInferred, Model: unknown},
+		},
+		{
+			UA:          "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0",
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: frozen, Platform: exact, Model: unknown},
+		},
+		{
+			UA:          "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0",
+			Hints:       gopheragent.Hints{Platform: "Windows", PlatformVersion: "15.0.0"},
+			Confidences: gopheragent.Confidences{BrowserVersion: exact, OS: exact, Platform: exact, Model: unknown},
+		},
+		{
+			UA:          "curl/8.4.0",
+			Confidences: gopheragent.Confidences{BrowserVersion: unknown, OS: unknown, Platform: unknown, Model: unknown},
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.NewWithHints(test.UA, test.Hints)
+
+		if got := ua.Reduced(); got != test.Reduced {
+			t.Errorf("UserAgent.Reduced[%s] => %t; want %t", test.UA, got, test.Reduced)
+		}
+
+		if got := ua.Model(); got != test.Model {
+			t.Errorf("UserAgent.Model[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.Model)
+		}
+
+		if got := ua.Confidence(); got != test.Confidences {
+			t.Errorf("UserAgent.Confidence[%s, %+v] => %+v; want %+v", test.UA, test.Hints, got, test.Confidences)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package gopheragent_test
+
+import (
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+type deviceTestCase struct {
+	UA,
+	DeviceType string
+}
+
+func Test_UserAgent_DeviceType(t *testing.T) {
+
+	tests := []deviceTestCase{
+		{
+			UA:         "Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; SLCC2; .NET CLR 2.0.50727; Media Center PC 6.0; Tablet PC 2.0; rv:11.0) like Gecko",
+			DeviceType: gopheragent.DeviceDesktop,
+		},
+		{
+			UA:         "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_9_4) AppleWebKit/537.77.4 (KHTML, like Gecko) Version/7.0.5 Safari/537.77.4",
+			DeviceType: gopheragent.DeviceDesktop,
+		},
+		{
+			UA:         "Mozilla/5.0 (iPhone; CPU iPhone OS 7_1_2 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) Version/7.0 Mobile/11D257 Safari/9537.53",
+			DeviceType: gopheragent.DevicePhone,
+		},
+		{
+			UA:         "Mozilla/5.0 (iPad; CPU OS 7_1_2 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) Version/7.0 Mobile/11D257 Safari/9537.53",
+			DeviceType: gopheragent.DeviceTablet,
+		},
+		{
+			UA:         "Mozilla/5.0 (Linux; Android 4.4.2; Nexus 5 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
+			DeviceType: gopheragent.DevicePhone,
+		},
+		{
+			UA:         "Mozilla/5.0 (Linux; Android 4.4.2; SM-T230NU Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Safari/537.36",
+			DeviceType: gopheragent.DeviceTablet,
+		},
+		{
+			UA:         "Mozilla/5.0 (Android 4.4; Tablet; rv:41.0) Gecko/41.0 Firefox/41.0",
+			DeviceType: gopheragent.DeviceTablet,
+		},
+		{
+			UA:         "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+			DeviceType: gopheragent.DeviceTablet,
+		},
+		{
+			UA:         "Dalvik/1.6.0 (Linux; U; Android 4.4.2; SM-N900T Build/KOT49H)",
+			DeviceType: gopheragent.Unknown,
+		},
+		{
+			UA:         "okhttp/4.12.0 (Linux; Android 13; Pixel 7)",
+			DeviceType: gopheragent.Unknown,
+		},
+		{
+			UA:         "Mozilla/5.0 (SMART-TV; Linux; Tizen 2.4.0) AppleWebkit/538.1 (KHTML, like Gecko) SamsungBrowser/1.1 TV Safari/538.1",
+			DeviceType: gopheragent.DeviceTV,
+		},
+		{
+			UA:         "Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox One) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19041",
+			DeviceType: gopheragent.DeviceConsole,
+		},
+		{
+			UA:         "Mozilla/5.0 (PlayStation 4 3.11) AppleWebKit/537.73 (KHTML, like Gecko)",
+			DeviceType: gopheragent.DeviceConsole,
+		},
+		{
+			UA:         "Mozilla/5.0 (Linux; Android 8.0.0; Wear OS by Google Build/OWDD.180402.010) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/62.0.3202.84 Safari/537.36",
+			DeviceType: gopheragent.DeviceWearable,
+		},
+		{
+			UA:         "Mozilla/5.0 (X11; U; Linux armv7l like Android; en-us) AppleWebKit/531.2+ (KHTML, like Gecko) Version/5.0 Safari/533.2+ Kindle/3.0+",
+			DeviceType: gopheragent.DeviceEmbedded,
+		},
+		{
+			UA:         "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			DeviceType: gopheragent.DeviceBot,
+		},
+		{
+			UA:         "curl/7.35.0",
+			DeviceType: gopheragent.Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.New(test.UA)
+
+		if got := ua.DeviceType(); got != test.DeviceType {
+			t.Errorf("UserAgent.DeviceType[%s] => %s; want %s", test.UA, got, test.DeviceType)
+		}
+
+		if got := ua.Tablet(); got != (test.DeviceType == gopheragent.DeviceTablet) {
+			t.Errorf("UserAgent.Tablet[%s] => %t; want %t", test.UA, got, !got)
+		}
+
+		if got := ua.Desktop(); got != (test.DeviceType == gopheragent.DeviceDesktop) {
+			t.Errorf("UserAgent.Desktop[%s] => %t; want %t", test.UA, got, !got)
+		}
+	}
+}
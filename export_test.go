@@ -0,0 +1,11 @@
+package gopheragent
+
+// WithoutPrefilter makes the parser run every regexp of its rules, to compare
+// against the keyword prefilter in benchmarks
+func WithoutPrefilter() Option {
+	return func(p *Parser) {
+		rules := *p.rules
+		rules.scanner = nil
+		p.rules = &rules
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/remind101/gopheragent
+
+go 1.16
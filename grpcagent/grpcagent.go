@@ -0,0 +1,118 @@
+// Package grpcagent provides gRPC server interceptors which parse the user
+// agent of the client and attach it to the request context, as
+// gopheragent.Middleware does for net/http. Handlers retrieve it with
+// gopheragent.FromContext.
+package grpcagent
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/remind101/gopheragent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// transport matches the suffix gRPC implementations append to the user agent
+// of the application, e.g. grpc-go/1.64.0 or grpc-c/38.0.0 (ios; cfstream)
+var transport = regexp.MustCompile(`(?i)\s*\bgrpc-[a-z-]+\/[\w.-]+(?:\s*\([^)]*\))?\s*$`)
+
+// StripTransport returns the user agent of the application, without the
+// suffixes gRPC implementations append to it
+func StripTransport(ua string) string {
+
+	for {
+		stripped := transport.ReplaceAllString(ua, "")
+		if stripped == ua {
+			return strings.TrimSpace(ua)
+		}
+
+		ua = stripped
+	}
+}
+
+// Option configures the interceptors
+type Option func(*interceptor)
+
+type interceptor struct {
+	parser *gopheragent.Parser
+}
+
+// WithParser sets the parser used by the interceptors instead of the default
+// one
+func WithParser(p *gopheragent.Parser) Option {
+	return func(i *interceptor) {
+		i.parser = p
+	}
+}
+
+func newInterceptor(opts []Option) *interceptor {
+
+	var i interceptor
+
+	for _, opt := range opts {
+		opt(&i)
+	}
+
+	return &i
+}
+
+// UnaryServerInterceptor returns a unary interceptor attaching the user agent
+// of the client to the context of each call
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+
+	i := newInterceptor(opts)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(i.context(ctx), req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor attaching the user
+// agent of the client to the context of each stream
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+
+	i := newInterceptor(opts)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: i.context(ss.Context())})
+	}
+}
+
+// context returns a copy of ctx carrying the user agent found in its incoming
+// metadata, refined by the client hints it may carry
+func (i *interceptor) context(ctx context.Context) context.Context {
+
+	// the user agent was already attached by another interceptor
+	if _, ok := gopheragent.FromContext(ctx); ok {
+		return ctx
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	header := http.Header{}
+	for key, values := range md {
+		header[http.CanonicalHeaderKey(key)] = values
+	}
+
+	header.Set("User-Agent", StripTransport(header.Get("User-Agent")))
+
+	p := i.parser
+	if p == nil {
+		p = gopheragent.DefaultParser()
+	}
+
+	return gopheragent.NewContext(ctx, p.NewFromHeaders(header))
+}
+
+// serverStream overrides the context of a stream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,114 @@
+package grpcagent_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+	"github.com/remind101/gopheragent/grpcagent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const iPhone = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148"
+
+func Test_StripTransport(t *testing.T) {
+
+	tests := map[string]string{
+		"MyApp/2.3 (iPhone; iOS 17.1) grpc-go/1.64.0":                       "MyApp/2.3 (iPhone; iOS 17.1)",
+		"MyApp/2.3 grpc-java-okhttp/1.62.2":                                 "MyApp/2.3",
+		"MyApp/2.3 grpc-objc-cfstream/1.59.2 grpc-c/36.0.0 (ios; cfstream)": "MyApp/2.3",
+		"grpc-go/1.64.0": "",
+		"MyApp/2.3":      "MyApp/2.3",
+	}
+
+	for ua, want := range tests {
+		if got := grpcagent.StripTransport(ua); got != want {
+			t.Errorf("StripTransport[%s] => %q; want %q", ua, got, want)
+		}
+	}
+}
+
+func Test_Interceptors(t *testing.T) {
+
+	got := make(chan *gopheragent.UserAgent, 2)
+
+	record := func(ctx context.Context) {
+		ua, _ := gopheragent.FromContext(ctx)
+		got <- ua
+	}
+
+	lis := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpcagent.UnaryServerInterceptor(),
+			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				record(ctx)
+				return handler(ctx, req)
+			},
+		),
+		grpc.ChainStreamInterceptor(
+			grpcagent.StreamServerInterceptor(),
+			func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				record(ss.Context())
+				return handler(srv, ss)
+			},
+		),
+	)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUserAgent(iPhone),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient => %v", err)
+	}
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Health.Check => %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Health.Watch => %v", err)
+	}
+
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Health.Watch.Recv => %v", err)
+	}
+
+	for _, call := range []string{"unary", "stream"} {
+		ua := <-got
+
+		if ua == nil {
+			t.Errorf("FromContext[%s] => nil; want a UserAgent", call)
+			continue
+		}
+
+		if platform := ua.Platform(); platform != gopheragent.Iphone {
+			t.Errorf("UserAgent.Platform[%s] => %s; want %s", call, platform, gopheragent.Iphone)
+		}
+
+		if os := ua.OS(); os != "iOS 17.1" {
+			t.Errorf("UserAgent.OS[%s] => %s; want iOS 17.1", call, os)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package gopheragent
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Client hint headers
+const (
+	HeaderUA                = "Sec-CH-UA"
+	HeaderUAMobile          = "Sec-CH-UA-Mobile"
+	HeaderUAPlatform        = "Sec-CH-UA-Platform"
+	HeaderUAPlatformVersion = "Sec-CH-UA-Platform-Version"
+	HeaderUAModel           = "Sec-CH-UA-Model"
+	HeaderUAFullVersionList = "Sec-CH-UA-Full-Version-List"
+	HeaderUAArch            = "Sec-CH-UA-Arch"
+	HeaderUABitness         = "Sec-CH-UA-Bitness"
+)
+
+// grease matches the made up brands Chromium adds to Sec-CH-UA so that
+// servers do not rely on its exact contents, e.g. "Not-A.Brand"
+var grease = regexp.MustCompile(`(?i)^[\W_]*not[\W_]*a[\W_]*brand[\W_]*$`)
+
+// NewFromHeaders returns a UserAgent for the User-Agent header of a request,
+// refined by its client hint headers
+func NewFromHeaders(header http.Header) *UserAgent {
+	return defaultParser.NewFromHeaders(header)
+}
+
+// NewFromHeaders returns a UserAgent for the User-Agent header of a request,
+// refined by its client hint headers. User agents are cached by UA string and
+// hints.
+func (p *Parser) NewFromHeaders(header http.Header) *UserAgent {
+	return p.NewWithHints(header.Get("User-Agent"), HintsFromHeaders(header))
+}
+
+// HintsFromHeaders parses the client hint headers of a request. Malformed
+// headers are ignored.
+func HintsFromHeaders(header http.Header) Hints {
+
+	return Hints{
+		Platform:        sfString(header.Get(HeaderUAPlatform)),
+		PlatformVersion: sfString(header.Get(HeaderUAPlatformVersion)),
+		Brands:          sfBrands(header.Get(HeaderUA)),
+		FullVersionList: sfBrands(header.Get(HeaderUAFullVersionList)),
+		Mobile:          strings.TrimSpace(header.Get(HeaderUAMobile)) == "?1",
+		Model:           sfString(header.Get(HeaderUAModel)),
+		Arch:            sfString(header.Get(HeaderUAArch)),
+		Bitness:         sfString(header.Get(HeaderUABitness)),
+	}
+}
+
+// sfBrands parses a structured header list of brands such as
+// "Chromium";v="124", "Google Chrome";v="124", leaving out GREASE brands
+func sfBrands(s string) []Brand {
+
+	var brands []Brand
+
+	for s = strings.TrimSpace(s); s != ""; {
+		brand, rest, ok := sfQuoted(s)
+		if !ok {
+			return brands
+		}
+
+		b := Brand{Brand: brand}
+
+		// parameters
+		for s = rest; strings.HasPrefix(s, ";"); {
+			s = strings.TrimLeft(s[1:], " ")
+
+			i := strings.IndexAny(s, "=;, ")
+			if i < 0 || s[i] != '=' {
+				return brands
+			}
+
+			key := s[:i]
+			value, rest, ok := sfQuoted(s[i+1:])
+			if !ok {
+				return brands
+			}
+
+			if key == "v" {
+				b.Version = value
+			}
+
+			s = rest
+		}
+
+		if !grease.MatchString(b.Brand) {
+			brands = append(brands, b)
+		}
+
+		s = strings.TrimSpace(s)
+		if s == "" {
+			break
+		}
+
+		if s[0] != ',' {
+			return brands
+		}
+
+		s = strings.TrimSpace(s[1:])
+	}
+
+	return brands
+}
+
+// sfString parses a structured header string such as "Windows"
+func sfString(s string) string {
+
+	value, rest, ok := sfQuoted(strings.TrimSpace(s))
+	if !ok || strings.TrimSpace(rest) != "" {
+		return ""
+	}
+
+	return value
+}
+
+// sfQuoted parses the quoted string s starts with, returning its value and
+// what follows it
+func sfQuoted(s string) (value, rest string, ok bool) {
+
+	if s == "" || s[0] != '"' {
+		return "", s, false
+	}
+
+	var b strings.Builder
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) {
+				return "", s, false
+			}
+
+			i++
+			b.WriteByte(s[i])
+
+		case '"':
+			return b.String(), s[i+1:], true
+
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return "", s, false
+}
+
+// Hint is a set of UserAgent details only known precisely from high entropy
+// client hints, which browsers only send when asked to
+type Hint int
+
+// High entropy hints
+const (
+	// HintFullVersion is the full browser version, from
+	// Sec-CH-UA-Full-Version-List
+	HintFullVersion Hint = 1 << iota
+
+	// HintPlatformVersion is the OS version, from Sec-CH-UA-Platform-Version
+	HintPlatformVersion
+
+	// HintModel is the device model, from Sec-CH-UA-Model
+	HintModel
+
+	// HintArch is the CPU architecture, from Sec-CH-UA-Arch and
+	// Sec-CH-UA-Bitness
+	HintArch
+)
+
+var hintHeaders = []struct {
+	hint    Hint
+	headers []string
+}{
+	{HintFullVersion, []string{HeaderUAFullVersionList}},
+	{HintPlatformVersion, []string{HeaderUAPlatformVersion}},
+	{HintModel, []string{HeaderUAModel}},
+	{HintArch, []string{HeaderUAArch, HeaderUABitness}},
+}
+
+// RequestHints asks the client for the headers of the given hints by setting
+// the Accept-CH, Permissions-Policy and Vary response headers, and Critical-CH
+// if critical for the browser to retry the request at once with them. It
+// returns true if the request already carries every hint. Browsers without
+// client hints support never send them.
+func RequestHints(w http.ResponseWriter, r *http.Request, hints Hint, critical bool) bool {
+
+	var headers, policies []string
+	found := true
+
+	for _, hh := range hintHeaders {
+		if hints&hh.hint == 0 {
+			continue
+		}
+
+		for _, name := range hh.headers {
+			headers = append(headers, name)
+
+			// Sec-CH-UA-Model is delegated as ch-ua-model
+			policies = append(policies, strings.ToLower(strings.TrimPrefix(name, "Sec-"))+"=(self)")
+
+			if len(r.Header[http.CanonicalHeaderKey(name)]) == 0 {
+				found = false
+			}
+		}
+	}
+
+	if len(headers) == 0 {
+		return true
+	}
+
+	list := strings.Join(headers, ", ")
+	header := w.Header()
+
+	header.Add("Accept-CH", list)
+	if critical {
+		header.Add("Critical-CH", list)
+	}
+
+	header.Add("Permissions-Policy", strings.Join(policies, ", "))
+	header.Add("Vary", list)
+
+	return found
+}
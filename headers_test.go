@@ -0,0 +1,220 @@
+package gopheragent_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_NewFromHeaders(t *testing.T) {
+
+	tests := []struct {
+		Header http.Header
+		BrowserName,
+		BrowserVersion,
+		OS,
+		Platform,
+		Arch string
+		Mobile bool
+	}{
+		{
+			Header: http.Header{
+				"User-Agent":                  {"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"},
+				"Sec-Ch-Ua":                   {`"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`},
+				"Sec-Ch-Ua-Full-Version-List": {`"Chromium";v="124.0.6367.91", "Google Chrome";v="124.0.6367.91", "Not-A.Brand";v="99.0.0.0"`},
+				"Sec-Ch-Ua-Mobile":            {"?0"},
+				"Sec-Ch-Ua-Platform":          {`"Windows"`},
+				"Sec-Ch-Ua-Platform-Version":  {`"15.0.0"`},
+				"Sec-Ch-Ua-Arch":              {`"arm"`},
+				"Sec-Ch-Ua-Bitness":           {`"64"`},
+			},
+			BrowserName:    "chrome",
+			BrowserVersion: "124.0.6367.91",
+			OS:             "Windows 11",
+			Platform:       "windows",
+			Arch:           "arm64",
+		},
+		{
+			Header: http.Header{
+				"User-Agent":         {"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.0.0"},
+				"Sec-Ch-Ua":          {`"Chromium";v="124", "Microsoft Edge";v="124", "Not-A.Brand";v="99"`},
+				"Sec-Ch-Ua-Mobile":   {"?0"},
+				"Sec-Ch-Ua-Platform": {`"Windows"`},
+			},
+			BrowserName:    "edge",
+			BrowserVersion: "124",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Arch:           "x64",
+		},
+		{
+			Header: http.Header{
+				"User-Agent":                 {"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36"},
+				"Sec-Ch-Ua":                  {`"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`},
+				"Sec-Ch-Ua-Mobile":           {"?1"},
+				"Sec-Ch-Ua-Platform":         {`"Android"`},
+				"Sec-Ch-Ua-Platform-Version": {`"14.0.0"`},
+				"Sec-Ch-Ua-Model":            {`"Pixel 7"`},
+			},
+			BrowserName:    "chrome",
+			BrowserVersion: "124",
+			OS:             "Android 14",
+			Platform:       "android",
+			Mobile:         true,
+		},
+		{
+			Header: http.Header{
+				"User-Agent":         {"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/99.0.4844.51 Safari/537.36"},
+				"Sec-Ch-Ua":          {`" Not A;Brand";v="99", "Chromium";v="99", "Google Chrome";v="99"`},
+				"Sec-Ch-Ua-Mobile":   {"?0"},
+				"Sec-Ch-Ua-Platform": {`"Windows"`},
+			},
+			BrowserName:    "chrome",
+			BrowserVersion: "99.0.4844.51",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Arch:           "x64",
+		},
+		{
+			Header: http.Header{
+				"User-Agent":         {"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"},
+				"Sec-Ch-Ua-Mobile":   {"?0"},
+				"Sec-Ch-Ua-Platform": {`"Android"`},
+			},
+			BrowserName:    "chrome",
+			BrowserVersion: "124.0.0.0",
+			OS:             "Android 10",
+			Platform:       "android",
+			// Android tablets send ?0 yet are mobile like iPads
+			Mobile: true,
+		},
+		{
+			Header: http.Header{
+				"User-Agent": {"Mozilla/5.0 (Macintosh; Intel Mac OS X 10.9; rv:31.0) Gecko/20100101 Firefox/31.0"},
+			},
+			BrowserName:    "firefox",
+			BrowserVersion: "31.0",
+			OS:             "macOS 10.9",
+			Platform:       "macintosh",
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.NewFromHeaders(test.Header)
+		name := test.Header.Get("User-Agent")
+
+		if got := ua.BrowserName(); got != test.BrowserName {
+			t.Errorf("UserAgent.BrowserName[%s] => %s; want %s", name, got, test.BrowserName)
+		}
+
+		if got := ua.BrowserVersion(); got != test.BrowserVersion {
+			t.Errorf("UserAgent.BrowserVersion[%s] => %s; want %s", name, got, test.BrowserVersion)
+		}
+
+		if got := ua.OS(); got != test.OS {
+			t.Errorf("UserAgent.OS[%s] => %s; want %s", name, got, test.OS)
+		}
+
+		if got := ua.Platform(); got != test.Platform {
+			t.Errorf("UserAgent.Platform[%s] => %s; want %s", name, got, test.Platform)
+		}
+
+		if got := ua.Arch(); got != test.Arch {
+			t.Errorf("UserAgent.Arch[%s] => %s; want %s", name, got, test.Arch)
+		}
+
+		if got := ua.Mobile(); got != test.Mobile {
+			t.Errorf("UserAgent.Mobile[%s] => %t; want %t", name, got, test.Mobile)
+		}
+	}
+}
+
+func Test_HintsFromHeaders(t *testing.T) {
+
+	header := http.Header{
+		"Sec-Ch-Ua":          {`"Not/A)Brand";v="8", "Chromium";v="126", "Opera";v="112", "Esc\"aped";v="1"`},
+		"Sec-Ch-Ua-Mobile":   {"?0"},
+		"Sec-Ch-Ua-Platform": {`"macOS"`},
+		"Sec-Ch-Ua-Model":    {`""`},
+		"Sec-Ch-Ua-Arch":     {`x86`},
+	}
+
+	want := gopheragent.Hints{
+		Platform: "macOS",
+		Brands: []gopheragent.Brand{
+			{Brand: "Chromium", Version: "126"},
+			{Brand: "Opera", Version: "112"},
+			{Brand: `Esc"aped`, Version: "1"},
+		},
+	}
+
+	if got := gopheragent.HintsFromHeaders(header); !reflect.DeepEqual(got, want) {
+		t.Errorf("HintsFromHeaders => %+v; want %+v", got, want)
+	}
+
+	header.Set("Sec-CH-UA", `"Chromium";v="126", Opera;v="112"`)
+
+	if got := gopheragent.HintsFromHeaders(header).Brands; len(got) != 1 {
+		t.Errorf("HintsFromHeaders.Brands => %+v; want Chromium only", got)
+	}
+}
+
+func Test_HintsFromHeaders_GREASE(t *testing.T) {
+
+	tests := []string{
+		`"Not_A Brand";v="8"`,
+		`"Not?A_Brand";v="24"`,
+		`"Not A(Brand";v="99"`,
+		`" Not A;Brand";v="99"`,
+		`"Not.A/Brand";v="8"`,
+		`"Not)A;Brand";v="24"`,
+		`"Not-A.Brand";v="99"`,
+	}
+
+	want := []gopheragent.Brand{{Brand: "Chromium", Version: "120"}}
+
+	for _, grease := range tests {
+		header := http.Header{"Sec-Ch-Ua": {grease + `, "Chromium";v="120"`}}
+
+		if got := gopheragent.HintsFromHeaders(header).Brands; !reflect.DeepEqual(got, want) {
+			t.Errorf("HintsFromHeaders.Brands[%s] => %+v; want %+v", grease, got, want)
+		}
+	}
+}
+
+func Test_RequestHints(t *testing.T) {
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Sec-CH-UA-Platform-Version", `"15.0.0"`)
+	r.Header.Set("Sec-CH-UA-Model", `""`)
+
+	w := httptest.NewRecorder()
+
+	if gopheragent.RequestHints(w, r, gopheragent.HintPlatformVersion|gopheragent.HintArch, true) {
+		t.Errorf("RequestHints => true; want false without Sec-CH-UA-Arch")
+	}
+
+	want := http.Header{
+		"Accept-Ch":          {"Sec-CH-UA-Platform-Version, Sec-CH-UA-Arch, Sec-CH-UA-Bitness"},
+		"Critical-Ch":        {"Sec-CH-UA-Platform-Version, Sec-CH-UA-Arch, Sec-CH-UA-Bitness"},
+		"Permissions-Policy": {"ch-ua-platform-version=(self), ch-ua-arch=(self), ch-ua-bitness=(self)"},
+		"Vary":               {"Sec-CH-UA-Platform-Version, Sec-CH-UA-Arch, Sec-CH-UA-Bitness"},
+	}
+
+	if got := w.Header(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RequestHints headers => %v; want %v", got, want)
+	}
+
+	w = httptest.NewRecorder()
+
+	if !gopheragent.RequestHints(w, r, gopheragent.HintPlatformVersion|gopheragent.HintModel, false) {
+		t.Errorf("RequestHints => false; want true")
+	}
+
+	if got := w.Header().Get("Critical-CH"); got != "" {
+		t.Errorf("RequestHints Critical-CH => %s; want none", got)
+	}
+}
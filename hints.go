@@ -0,0 +1,304 @@
+package gopheragent
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Hints holds the User-Agent Client Hints sent along with a UA string. Values
+// are unquoted, e.g. Windows rather than "Windows".
+type Hints struct {
+	// Platform is the Sec-CH-UA-Platform hint, e.g. Windows
+	Platform string
+
+	// PlatformVersion is the Sec-CH-UA-Platform-Version hint, e.g. 15.0.0
+	PlatformVersion string
+
+	// MaxTouchPoints is navigator.maxTouchPoints as reported by the client.
+	// Macs have none, which tells them from iPads in desktop mode.
+	MaxTouchPoints int
+
+	// Brands is the Sec-CH-UA hint, e.g. Google Chrome 124, without GREASE
+	// brands
+	Brands []Brand
+
+	// FullVersionList is the Sec-CH-UA-Full-Version-List hint, e.g. Google
+	// Chrome 124.0.6367.91, without GREASE brands
+	FullVersionList []Brand
+
+	// Mobile is the Sec-CH-UA-Mobile hint. It is only taken into account
+	// along with Platform, both being sent with every request.
+	Mobile bool
+
+	// Model is the Sec-CH-UA-Model hint, e.g. Pixel 7
+	Model string
+
+	// Arch and Bitness are the Sec-CH-UA-Arch and Sec-CH-UA-Bitness hints,
+	// e.g. x86 and 64
+	Arch,
+	Bitness string
+}
+
+// Brand is a browser brand and its version, as listed by the Sec-CH-UA hints
+type Brand struct {
+	Brand,
+	Version string
+}
+
+// hintBrowsers maps Sec-CH-UA brands onto browser names. Chromium is left out
+// as it is listed by every browser based on it.
+var hintBrowsers = map[string]string{
+	"Google Chrome":    Chrome,
+	"Microsoft Edge":   Edge,
+	"Opera":            Opera,
+	"Brave":            Brave,
+	"Vivaldi":          Vivaldi,
+	"YaBrowser":        Yandex,
+	"Yandex":           Yandex,
+	"Samsung Internet": Samsung,
+}
+
+// hintPlatforms maps Sec-CH-UA-Platform hints onto platforms
+var hintPlatforms = map[string]string{
+	"Windows": Windows,
+	"macOS":   Mac,
+	"Linux":   Linux,
+	"Android": Android,
+}
+
+// NewWithHints returns a UserAgent for the given UA string, refined by the
+// given client hints
+func NewWithHints(ua string, h Hints) *UserAgent {
+	return defaultParser.NewWithHints(ua, h)
+}
+
+// NewWithHints returns a UserAgent for the given UA string, refined by the
+// given client hints. User agents are cached by UA string and hints.
+func (p *Parser) NewWithHints(ua string, h Hints) *UserAgent {
+
+	if h.empty() {
+		return p.New(ua)
+	}
+
+	key := ""
+
+	if p.cache != nil {
+		key = ua + h.key()
+
+		if result, ok := p.cache.get(key); ok {
+			return result
+		}
+	}
+
+	result := p.newUserAgent(ua)
+	result.hints = h
+
+	if p.cache != nil {
+		p.cache.add(key, result)
+	}
+
+	return result
+}
+
+// empty returns true if no hints were given
+func (h Hints) empty() bool {
+
+	return h.Platform == "" && h.PlatformVersion == "" && h.MaxTouchPoints == 0 &&
+		len(h.Brands) == 0 && len(h.FullVersionList) == 0 && !h.Mobile &&
+		h.Model == "" && h.Arch == "" && h.Bitness == ""
+}
+
+// key returns the hints as a suffix of cache keys, every value being preceded
+// by a NUL byte which neither UA strings nor headers contain
+func (h Hints) key() string {
+
+	var b strings.Builder
+
+	write := func(s string) {
+		b.WriteByte(0)
+		b.WriteString(s)
+	}
+
+	write(h.Platform)
+	write(h.PlatformVersion)
+	write(strconv.Itoa(h.MaxTouchPoints))
+
+	for _, brands := range [][]Brand{h.Brands, h.FullVersionList} {
+		write(strconv.Itoa(len(brands)))
+
+		for _, brand := range brands {
+			write(brand.Brand)
+			write(brand.Version)
+		}
+	}
+
+	write(strconv.FormatBool(h.Mobile))
+	write(h.Model)
+	write(h.Arch)
+	write(h.Bitness)
+
+	return b.String()
+}
+
+// refineOS returns the operating system and its version, preferring the
+// client hints over the ones found in the UA string, and whether the hints
+// were used
+func (h Hints) refineOS(os, version string) (string, string, bool) {
+
+	switch h.Platform {
+	case "Windows":
+		if v := windowsVersion(h.PlatformVersion); v != "" {
+			return "Windows " + v, v, true
+		}
+
+	case "macOS":
+		if name := macOSVersion(h.PlatformVersion); name != "" {
+			return name, h.PlatformVersion, true
+		}
+
+	case "Android":
+		if v := trimVersion(h.PlatformVersion); v != "" {
+			return "Android " + v, v, true
+		}
+	}
+
+	return os, version, false
+}
+
+// refineBrowser returns the browser and its version, preferring the brands
+// of the client hints over the ones found in the UA string, and whether the
+// hints were used. Sec-CH-UA only gives major versions, so the version of the
+// UA string is kept when it agrees with them, unless the UA string is
+// reduced.
+func (h Hints) refineBrowser(browser, version string, reduced bool) (string, string, bool) {
+
+	if name, full, ok := brandVersion(h.FullVersionList, browser); ok {
+		return name, full, true
+	}
+
+	name, major, ok := brandVersion(h.Brands, browser)
+	if !ok {
+		return browser, version, false
+	}
+
+	if name == browser && !reduced && version != "" && ParseVersion(version).Major == ParseVersion(major).Major {
+		return name, version, true
+	}
+
+	return name, major, true
+}
+
+// brandVersion returns the first known browser among brands and its version,
+// falling back on the Chromium brand for Chrome
+func brandVersion(brands []Brand, browser string) (string, string, bool) {
+
+	chromium := ""
+
+	for _, b := range brands {
+		if name, ok := hintBrowsers[b.Brand]; ok {
+			return name, b.Version, true
+		}
+
+		if b.Brand == "Chromium" {
+			chromium = b.Version
+		}
+	}
+
+	if chromium != "" && browser == Chrome {
+		return browser, chromium, true
+	}
+
+	return "", "", false
+}
+
+// refineArch returns the architecture given by the client hints, or arch
+func (h Hints) refineArch(arch string) string {
+
+	switch {
+	case h.Arch == "x86" && h.Bitness == "64":
+		return ArchX64
+	case h.Arch == "x86" && h.Bitness == "32":
+		return ArchX86
+	case h.Arch == "arm" && h.Bitness == "64":
+		return ArchARM64
+	case h.Arch == "arm" && h.Bitness == "32":
+		return ArchARM
+	}
+
+	return arch
+}
+
+// trimVersion strips trailing zero components from a version, e.g. 14.0.0
+// becomes 14
+func trimVersion(version string) string {
+
+	for strings.HasSuffix(version, ".0") {
+		version = strings.TrimSuffix(version, ".0")
+	}
+
+	if version == "0" {
+		return ""
+	}
+
+	return version
+}
+
+// windowsVersion maps a Windows Sec-CH-UA-Platform-Version onto a release
+// version, e.g. 11 for 15.0.0, which is the only way to tell Windows 11 from
+// Windows 10. Server editions report the same versions as their client
+// counterparts.
+func windowsVersion(platformVersion string) string {
+
+	parts := strings.SplitN(platformVersion, ".", 3)
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case major >= 13:
+		return "11"
+	case major >= 1:
+		return "10"
+	}
+
+	minor := 0
+	if len(parts) > 1 {
+		minor, _ = strconv.Atoi(parts[1])
+	}
+
+	switch minor {
+	case 1:
+		return "7"
+	case 2:
+		return "8"
+	case 3:
+		return "8.1"
+	}
+
+	return ""
+}
+
+// macOSVersion names the macOS release of a Sec-CH-UA-Platform-Version. Since
+// macOS 11 releases are named after their major version only.
+func macOSVersion(platformVersion string) string {
+
+	parts := strings.SplitN(platformVersion, ".", 3)
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ""
+	}
+
+	if major >= 11 || len(parts) < 2 {
+		return "macOS " + strconv.Itoa(major)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return ""
+	}
+
+	return "macOS " + strconv.Itoa(major) + "." + strconv.Itoa(minor)
+}
@@ -0,0 +1,175 @@
+package gopheragent_test
+
+import (
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+type hintsTestCase struct {
+	UA string
+	gopheragent.Hints
+	OS,
+	OSVersion,
+	MarketingName string
+	Frozen bool
+}
+
+func Test_NewWithHints(t *testing.T) {
+
+	const (
+		windows10 = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+		macOS     = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	)
+
+	tests := []hintsTestCase{
+		{
+			UA:     windows10,
+			OS:     "Windows 10",
+			Frozen: true,
+		},
+		{
+			UA:        windows10,
+			Hints:     gopheragent.Hints{Platform: "Windows", PlatformVersion: "15.0.0"},
+			OS:        "Windows 11",
+			OSVersion: "11",
+		},
+		{
+			UA:        windows10,
+			Hints:     gopheragent.Hints{Platform: "Windows", PlatformVersion: "10.0.0"},
+			OS:        "Windows 10",
+			OSVersion: "10",
+		},
+		{
+			UA:        windows10,
+			Hints:     gopheragent.Hints{Platform: "Windows", PlatformVersion: "0.3.0"},
+			OS:        "Windows 8.1",
+			OSVersion: "8.1",
+		},
+		{
+			UA:     windows10,
+			Hints:  gopheragent.Hints{Platform: "Windows", PlatformVersion: ""},
+			OS:     "Windows 10",
+			Frozen: true,
+		},
+		{
+			UA:        "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
+			Hints:     gopheragent.Hints{Platform: "Windows", PlatformVersion: "0.1.0"},
+			OS:        "Windows 7",
+			OSVersion: "7",
+		},
+		{
+			UA:            macOS,
+			OS:            "macOS 10.15",
+			OSVersion:     "10.15.7",
+			MarketingName: "Catalina",
+			Frozen:        true,
+		},
+		{
+			UA:            macOS,
+			Hints:         gopheragent.Hints{Platform: "macOS", PlatformVersion: "14.2.1"},
+			OS:            "macOS 14",
+			OSVersion:     "14.2.1",
+			MarketingName: "Sonoma",
+		},
+		{
+			UA:            macOS,
+			Hints:         gopheragent.Hints{Platform: "macOS", PlatformVersion: "10.15.7"},
+			OS:            "macOS 10.15",
+			OSVersion:     "10.15.7",
+			MarketingName: "Catalina",
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.NewWithHints(test.UA, test.Hints)
+
+		if got := ua.OS(); got != test.OS {
+			t.Errorf("UserAgent.OS[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.OS)
+		}
+
+		if test.OSVersion != "" {
+			if got := ua.OSVersion(); got != test.OSVersion {
+				t.Errorf("UserAgent.OSVersion[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.OSVersion)
+			}
+		}
+
+		if got := ua.OSMarketingName(); got != test.MarketingName {
+			t.Errorf("UserAgent.OSMarketingName[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.MarketingName)
+		}
+
+		if got := ua.OSFrozen(); got != test.Frozen {
+			t.Errorf("UserAgent.OSFrozen[%s, %+v] => %t; want %t", test.UA, test.Hints, got, test.Frozen)
+		}
+	}
+}
+
+func Test_NewWithHints_IPad(t *testing.T) {
+
+	const safari = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15"
+
+	tests := []struct {
+		UA string
+		gopheragent.Hints
+		BrowserName,
+		OS,
+		Platform string
+		Mobile bool
+	}{
+		{
+			UA:          safari,
+			BrowserName: "safari",
+			OS:          "macOS 10.15",
+			Platform:    "macintosh",
+		},
+		{
+			UA:          safari,
+			Hints:       gopheragent.Hints{MaxTouchPoints: 5},
+			BrowserName: "safari",
+			OS:          "iPadOS",
+			Platform:    "ipad",
+			Mobile:      true,
+		},
+		{
+			UA:          "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+			BrowserName: "chrome",
+			OS:          "iPadOS",
+			Platform:    "ipad",
+			Mobile:      true,
+		},
+		{
+			UA:          "Mozilla/5.0 (iPad; CPU OS 17_2_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) FxiOS/121.0 Mobile/15E148 Safari/605.1.15",
+			BrowserName: "firefox",
+			OS:          "iPadOS 17.2.1",
+			Platform:    "ipad",
+			Mobile:      true,
+		},
+		{
+			UA:          "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) EdgiOS/120.0.2210.126 Version/17.0 Mobile/15E148 Safari/604.1",
+			BrowserName: "edge",
+			OS:          "iOS 17.1",
+			Platform:    "iphone",
+			Mobile:      true,
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.NewWithHints(test.UA, test.Hints)
+
+		if got := ua.BrowserName(); got != test.BrowserName {
+			t.Errorf("UserAgent.BrowserName[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.BrowserName)
+		}
+
+		if got := ua.OS(); got != test.OS {
+			t.Errorf("UserAgent.OS[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.OS)
+		}
+
+		if got := ua.Platform(); got != test.Platform {
+			t.Errorf("UserAgent.Platform[%s, %+v] => %s; want %s", test.UA, test.Hints, got, test.Platform)
+		}
+
+		if got := ua.Mobile(); got != test.Mobile {
+			t.Errorf("UserAgent.Mobile[%s, %+v] => %t; want %t", test.UA, test.Hints, got, test.Mobile)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package gopheragent
+
+import (
+	"context"
+	"net/http"
+)
+
+// contextKey is the key of the UserAgent attached to a context
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the user agent
+func NewContext(ctx context.Context, ua *UserAgent) context.Context {
+	return context.WithValue(ctx, contextKey{}, ua)
+}
+
+// FromContext returns the user agent carried by ctx, as attached by
+// Middleware or NewContext
+func FromContext(ctx context.Context) (*UserAgent, bool) {
+
+	ua, ok := ctx.Value(contextKey{}).(*UserAgent)
+
+	return ua, ok && ua != nil
+}
+
+// MiddlewareOption configures a middleware returned by NewMiddleware
+type MiddlewareOption func(*middleware)
+
+type middleware struct {
+	parser   *Parser
+	hints    Hint
+	critical bool
+	next     http.Handler
+}
+
+// WithParser sets the parser used by the middleware instead of the default
+// one
+func WithParser(p *Parser) MiddlewareOption {
+	return func(m *middleware) {
+		m.parser = p
+	}
+}
+
+// WithRequestHints makes the middleware ask clients for the given high
+// entropy hints, as RequestHints does
+func WithRequestHints(hints Hint, critical bool) MiddlewareOption {
+	return func(m *middleware) {
+		m.hints, m.critical = hints, critical
+	}
+}
+
+// Middleware parses the user agent of each request, refined by its client
+// hint headers, and attaches it to the request context for FromContext
+func Middleware(next http.Handler) http.Handler {
+	return NewMiddleware()(next)
+}
+
+// NewMiddleware returns a middleware like Middleware configured with the given
+// options
+func NewMiddleware(opts ...MiddlewareOption) func(http.Handler) http.Handler {
+
+	return func(next http.Handler) http.Handler {
+
+		m := middleware{
+			next: next,
+		}
+
+		for _, opt := range opts {
+			opt(&m)
+		}
+
+		return &m
+	}
+}
+
+func (m *middleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if m.hints != 0 {
+		RequestHints(w, r, m.hints, m.critical)
+	}
+
+	// the request was already handled by another middleware
+	if _, ok := FromContext(r.Context()); ok {
+		m.next.ServeHTTP(w, r)
+		return
+	}
+
+	p := m.parser
+	if p == nil {
+		p = defaultParser
+	}
+
+	ua := p.NewFromHeaders(r.Header)
+
+	m.next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), ua)))
+}
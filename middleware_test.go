@@ -0,0 +1,122 @@
+package gopheragent_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_Middleware(t *testing.T) {
+
+	var got *gopheragent.UserAgent
+
+	handler := gopheragent.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = gopheragent.FromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36")
+	r.Header.Set("Sec-CH-UA-Platform", `"Windows"`)
+	r.Header.Set("Sec-CH-UA-Platform-Version", `"15.0.0"`)
+
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if got == nil {
+		t.Fatal("FromContext => nil; want a UserAgent")
+	}
+
+	if os := got.OS(); os != "Windows 11" {
+		t.Errorf("UserAgent.OS => %s; want Windows 11", os)
+	}
+}
+
+func Test_NewMiddleware(t *testing.T) {
+
+	const ua = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15"
+
+	cache := gopheragent.NewCache(10, 0)
+	parser := gopheragent.NewParser(gopheragent.WithCache(cache))
+
+	var got []*gopheragent.UserAgent
+
+	handler := gopheragent.NewMiddleware(
+		gopheragent.WithParser(parser),
+		gopheragent.WithRequestHints(gopheragent.HintPlatformVersion, false),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result, _ := gopheragent.FromContext(r.Context())
+		got = append(got, result)
+	}))
+
+	for i := 0; i < 2; i++ {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("User-Agent", ua)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if accept := w.Header().Get("Accept-CH"); accept != "Sec-CH-UA-Platform-Version" {
+			t.Errorf("Accept-CH => %q; want Sec-CH-UA-Platform-Version", accept)
+		}
+	}
+
+	// requests without client hints go through the parser cache
+	if len(got) != 2 || got[0] == nil || got[0] != got[1] {
+		t.Errorf("FromContext => %v; want the same cached UserAgent", got)
+	}
+
+	// user agents already attached to the request are kept
+	attached := gopheragent.New(ua)
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("User-Agent", ua)
+
+	handler.ServeHTTP(httptest.NewRecorder(), r.WithContext(gopheragent.NewContext(r.Context(), attached)))
+
+	if got[2] != attached {
+		t.Errorf("FromContext => %p; want %p", got[2], attached)
+	}
+}
+
+func Test_NewMiddleware_ClientHints(t *testing.T) {
+
+	parser := gopheragent.NewParser(gopheragent.WithCache(gopheragent.NewCache(10, 0)))
+
+	var got []*gopheragent.UserAgent
+
+	handler := gopheragent.NewMiddleware(gopheragent.WithParser(parser))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result, _ := gopheragent.FromContext(r.Context())
+		got = append(got, result)
+	}))
+
+	for _, version := range []string{"15.0.0", "15.0.0", "10.0.0"} {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36")
+		r.Header.Set("Sec-CH-UA", `"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`)
+		r.Header.Set("Sec-CH-UA-Platform", `"Windows"`)
+		r.Header.Set("Sec-CH-UA-Platform-Version", `"`+version+`"`)
+
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	// requests with the same client hints go through the parser cache
+	if got[0] != got[1] {
+		t.Errorf("FromContext => %p; want the cached %p", got[1], got[0])
+	}
+
+	if got[0] == got[2] {
+		t.Errorf("FromContext => %p; want a UserAgent for other client hints", got[2])
+	}
+
+	if os := got[2].OS(); os != "Windows 10" {
+		t.Errorf("UserAgent.OS => %s; want Windows 10", os)
+	}
+}
+
+func Test_FromContext_Missing(t *testing.T) {
+
+	if ua, ok := gopheragent.FromContext(context.Background()); ok || ua != nil {
+		t.Errorf("FromContext => %v, %t; want nil, false", ua, ok)
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !race
+// +build !race
+
+package gopheragent_test
+
+// raceEnabled is set when testing with the race detector, which makes
+// allocation counts unreliable
+const raceEnabled = false
@@ -0,0 +1,83 @@
+package gopheragent
+
+import "strings"
+
+// Parser produces UserAgents using its own set of rules
+type Parser struct {
+	rules *Rules
+	cache *Cache
+}
+
+// Option configures a Parser
+type Option func(*Parser)
+
+var defaultParser *Parser
+
+// NewParser returns a Parser configured with the given options. Without
+// options it uses the default rules.
+func NewParser(opts ...Option) *Parser {
+
+	p := Parser{
+		rules: defaultRules,
+	}
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return &p
+}
+
+// DefaultParser returns the parser used by New and Parse
+func DefaultParser() *Parser {
+	return defaultParser
+}
+
+// SetDefaultParser replaces the parser used by New and Parse, for instance to
+// put a cache in front of it. It is meant to be called during program
+// initialization, before any user agents are parsed.
+func SetDefaultParser(p *Parser) {
+	defaultParser = p
+}
+
+// WithRules sets the rules used by the parser
+func WithRules(r *Rules) Option {
+	return func(p *Parser) {
+		p.rules = r
+	}
+}
+
+// New returns a UserAgent for the given UA string
+func (p *Parser) New(ua string) *UserAgent {
+
+	if p.cache != nil {
+		if result, ok := p.cache.get(ua); ok {
+			return result
+		}
+	}
+
+	result := p.newUserAgent(ua)
+
+	if p.cache != nil {
+		p.cache.add(ua, result)
+	}
+
+	return result
+}
+
+func (p *Parser) newUserAgent(ua string) *UserAgent {
+	return &UserAgent{
+		rules: p.rules,
+		s:     strings.TrimSpace(ua),
+	}
+}
+
+// Cache returns the cache used by the parser, if any
+func (p *Parser) Cache() *Cache {
+	return p.cache
+}
+
+// Rules returns the rules used by the parser
+func (p *Parser) Rules() *Rules {
+	return p.rules
+}
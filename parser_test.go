@@ -0,0 +1,37 @@
+package gopheragent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_Parser_SideBySide(t *testing.T) {
+
+	rules, err := gopheragent.LoadRules(strings.NewReader(customRules))
+	if err != nil {
+		t.Fatalf("LoadRules => %v", err)
+	}
+
+	experimental := gopheragent.NewParser(gopheragent.WithRules(rules))
+	standard := gopheragent.NewParser()
+
+	const s = "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36 Gopher/2.0"
+
+	if got := standard.New(s).BrowserName(); got != gopheragent.Chrome {
+		t.Errorf("Parser.New(%s).BrowserName => %s; want %s", s, got, gopheragent.Chrome)
+	}
+
+	if got := experimental.New(s).BrowserName(); got != "gopher" {
+		t.Errorf("Parser.New(%s).BrowserName => %s; want gopher", s, got)
+	}
+
+	if got := gopheragent.New(s).BrowserName(); got != gopheragent.Chrome {
+		t.Errorf("New(%s).BrowserName => %s; want %s", s, got, gopheragent.Chrome)
+	}
+
+	if experimental.Rules() != rules {
+		t.Errorf("Parser.Rules => %p; want %p", experimental.Rules(), rules)
+	}
+}
@@ -0,0 +1,147 @@
+package gopheragent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// PolicySpec declares the browsers supported by a Policy, e.g. as JSON
+//
+//	{"browsers": {"chrome": "90", "safari": "14", "firefox": "115"}, "deny": ["ie"]}
+type PolicySpec struct {
+	// Browsers maps supported browser names onto their minimum version. An
+	// empty version supports every version.
+	Browsers map[string]string `json:"browsers"`
+	// Platforms restricts the supported platforms. Every platform is
+	// supported if empty.
+	Platforms []string `json:"platforms,omitempty"`
+	// Deny lists browser names which are never supported
+	Deny []string `json:"deny,omitempty"`
+	// AllowOthers supports browsers missing from Browsers
+	AllowOthers bool `json:"allow_others,omitempty"`
+	// FirefoxESR lists the major versions of Firefox ESR releases supported
+	// below the minimum Firefox version, e.g. ["115", "128"]
+	FirefoxESR []string `json:"firefox_esr,omitempty"`
+}
+
+// Policy tells supported browsers from the ones which should be served an
+// upgrade page. A Policy is safe for concurrent use.
+//
+// Firefox ESR sends the same UA string as the Firefox release of the same
+// major version, so supporting an ESR version through FirefoxESR also
+// supports that release.
+type Policy struct {
+	browsers    map[string]*Version
+	platforms   map[string]bool
+	deny        map[string]bool
+	firefoxESR  map[int]bool
+	allowOthers bool
+}
+
+// NewPolicy compiles a policy spec
+func NewPolicy(spec PolicySpec) (*Policy, error) {
+
+	p := Policy{
+		browsers:    map[string]*Version{},
+		platforms:   map[string]bool{},
+		deny:        map[string]bool{},
+		firefoxESR:  map[int]bool{},
+		allowOthers: spec.AllowOthers,
+	}
+
+	for _, name := range spec.Deny {
+		p.deny[name] = true
+	}
+
+	for name, min := range spec.Browsers {
+		if p.deny[name] {
+			return nil, fmt.Errorf("gopheragent: policy: %s is both supported and denied", name)
+		}
+
+		if min == "" {
+			p.browsers[name] = nil
+			continue
+		}
+
+		if min[0] < '0' || min[0] > '9' {
+			return nil, fmt.Errorf("gopheragent: policy: invalid version %q for %s", min, name)
+		}
+
+		v := ParseVersion(min)
+		p.browsers[name] = &v
+	}
+
+	for _, esr := range spec.FirefoxESR {
+		major, err := strconv.Atoi(esr)
+		if err != nil {
+			return nil, fmt.Errorf("gopheragent: policy: invalid Firefox ESR version %q", esr)
+		}
+
+		p.firefoxESR[major] = true
+	}
+
+	for _, platform := range spec.Platforms {
+		p.platforms[platform] = true
+	}
+
+	return &p, nil
+}
+
+// LoadPolicy reads and compiles a JSON policy spec
+func LoadPolicy(r io.Reader) (*Policy, error) {
+
+	var spec PolicySpec
+
+	if err := json.NewDecoder(r).Decode(&spec); err != nil {
+		return nil, err
+	}
+
+	return NewPolicy(spec)
+}
+
+// Allowed returns true if the policy supports the user agent, or else false
+// and the reason why it does not
+func (p *Policy) Allowed(ua *UserAgent) (bool, string) {
+
+	browser := ua.BrowserName()
+
+	if p.deny[browser] {
+		return false, fmt.Sprintf("%s is not supported", browser)
+	}
+
+	if platform := ua.Platform(); len(p.platforms) > 0 && !p.platforms[platform] {
+		return false, fmt.Sprintf("platform %s is not supported", platform)
+	}
+
+	min, ok := p.browsers[browser]
+	if !ok {
+		if p.allowOthers {
+			return true, ""
+		}
+
+		return false, fmt.Sprintf("%s is not supported", browser)
+	}
+
+	if min == nil {
+		return true, ""
+	}
+
+	version := ua.BrowserVersion()
+	if version == "" {
+		return false, fmt.Sprintf("%s version is unknown, %s or later is required", browser, min)
+	}
+
+	info := ua.BrowserVersionInfo()
+
+	if browser == Firefox && p.firefoxESR[info.Major] {
+		return true, ""
+	}
+
+	if info.Compare(*min) < 0 {
+		return false, fmt.Sprintf("%s %s is older than %s", browser, version, min)
+	}
+
+	return true, ""
+}
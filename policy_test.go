@@ -0,0 +1,122 @@
+package gopheragent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+const policySpec = `{
+  "browsers": {"chrome": "90", "safari": "14", "firefox": "115", "edge": ""},
+  "platforms": ["windows", "macintosh", "android", "iphone", "ipad"],
+  "deny": ["ie"]
+}`
+
+func Test_Policy_Allowed(t *testing.T) {
+
+	policy, err := gopheragent.LoadPolicy(strings.NewReader(policySpec))
+	if err != nil {
+		t.Fatalf("LoadPolicy => %v", err)
+	}
+
+	tests := []struct {
+		UA      string
+		Allowed bool
+		Reason  string
+	}{
+		{
+			UA:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Allowed: true,
+		},
+		{
+			UA:     "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
+			Reason: "chrome 36.0.1985.143 is older than 90.0",
+		},
+		{
+			UA:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edg/79.0.309.71",
+			Allowed: true,
+		},
+		{
+			UA:     "Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko",
+			Reason: "ie is not supported",
+		},
+		{
+			UA:     "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Reason: "platform linux is not supported",
+		},
+		{
+			UA:     "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0",
+			Reason: "opera is not supported",
+		},
+		{
+			UA:      "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			Allowed: true,
+		},
+	}
+
+	for _, test := range tests {
+		allowed, reason := policy.Allowed(gopheragent.New(test.UA))
+
+		if allowed != test.Allowed || reason != test.Reason {
+			t.Errorf("Policy.Allowed[%s] => %t, %q; want %t, %q", test.UA, allowed, reason, test.Allowed, test.Reason)
+		}
+	}
+}
+
+func Test_Policy_AllowOthers(t *testing.T) {
+
+	policy, err := gopheragent.NewPolicy(gopheragent.PolicySpec{
+		Browsers:    map[string]string{"chrome": "90"},
+		Deny:        []string{"ie"},
+		AllowOthers: true,
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy => %v", err)
+	}
+
+	ua := gopheragent.New("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0")
+
+	if allowed, reason := policy.Allowed(ua); !allowed {
+		t.Errorf("Policy.Allowed => %t, %q; want true", allowed, reason)
+	}
+}
+
+func Test_Policy_FirefoxESR(t *testing.T) {
+
+	policy, err := gopheragent.NewPolicy(gopheragent.PolicySpec{
+		Browsers:   map[string]string{"firefox": "130"},
+		FirefoxESR: []string{"115", "128"},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy => %v", err)
+	}
+
+	tests := map[string]string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0": "",
+		"Mozilla/5.0 (X11; Linux x86_64; rv:115.0) Gecko/20100101 Firefox/115.0":           "",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:127.0) Gecko/20100101 Firefox/127.0": "firefox 127.0 is older than 130.0",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:131.0) Gecko/20100101 Firefox/131.0": "",
+	}
+
+	for ua, want := range tests {
+		if allowed, reason := policy.Allowed(gopheragent.New(ua)); allowed != (want == "") || reason != want {
+			t.Errorf("Policy.Allowed[%s] => %t, %q; want %q", ua, allowed, reason, want)
+		}
+	}
+}
+
+func Test_NewPolicy_Errors(t *testing.T) {
+
+	tests := map[string]gopheragent.PolicySpec{
+		"version": {Browsers: map[string]string{"chrome": "latest"}},
+		"denied":  {Browsers: map[string]string{"ie": "11"}, Deny: []string{"ie"}},
+		"esr":     {Browsers: map[string]string{"firefox": "130"}, FirefoxESR: []string{"latest"}},
+	}
+
+	for name, spec := range tests {
+		if _, err := gopheragent.NewPolicy(spec); err == nil {
+			t.Errorf("NewPolicy[%s] => nil error; want error", name)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package gopheragent
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// keywordScanner is an Aho-Corasick automaton finding, in a single pass over
+// a user agent, every keyword required by the tests of a set of rules. Tests
+// whose keywords are absent from the user agent cannot match and are skipped
+// without running their regexp.
+type keywordScanner struct {
+	keywords []string
+	classes  [256]byte
+	width    int
+	next     []int32
+	out      [][]int
+}
+
+// keywordSet holds the keywords found in a user agent, indexed by keyword id.
+// A nil set disables the prefilter.
+type keywordSet []uint64
+
+func (ks keywordSet) has(id int) bool {
+	return ks[id/64]&(1<<uint(id%64)) != 0
+}
+
+// index extracts the keywords of every test and builds the scanner
+func (rules *Rules) index() {
+
+	ids := map[string]int{}
+	scanner := keywordScanner{}
+
+	for _, chain := range rules.chains() {
+		for _, test := range chain.tests {
+			var keywords []string
+			keywords, test.literal = requiredKeywords(test.Pattern)
+			test.keywords = nil
+
+			for _, k := range keywords {
+				id, ok := ids[k]
+				if !ok {
+					id = len(scanner.keywords)
+					ids[k] = id
+					scanner.keywords = append(scanner.keywords, k)
+				}
+
+				test.keywords = append(test.keywords, id)
+			}
+		}
+	}
+
+	scanner.build()
+	rules.scanner = &scanner
+}
+
+func (rules *Rules) chains() []*regexpTestChain {
+	return []*regexpTestChain{
+		&rules.browsers,
+		&rules.engines,
+		&rules.oses,
+		&rules.frozenOSes,
+		&rules.platforms,
+		&rules.ipadDesktopModes,
+		&rules.archs,
+		&rules.devices,
+		&rules.models,
+		&rules.reduced,
+		&rules.wow64,
+		&rules.bots,
+	}
+}
+
+// clone returns a copy of the chain with its own tests, so they can be
+// indexed by different rules
+func (tt regexpTestChain) clone() regexpTestChain {
+
+	tests := make([]*regexpTest, len(tt.tests))
+	for i, test := range tt.tests {
+		t := *test
+		tests[i] = &t
+	}
+
+	tt.tests = tests
+	return tt
+}
+
+// candidate reports whether the test may match a user agent containing the
+// keywords found
+func (test *regexpTest) candidate(found keywordSet) bool {
+
+	if found == nil || test.keywords == nil {
+		return true
+	}
+
+	for _, id := range test.keywords {
+		if found.has(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *keywordScanner) build() {
+
+	// map bytes found in keywords onto dense classes, 0 being any other byte
+	for _, k := range s.keywords {
+		for i := 0; i < len(k); i++ {
+			if s.classes[k[i]] == 0 {
+				s.width++
+				s.classes[k[i]] = byte(s.width)
+			}
+		}
+	}
+	s.width++
+
+	// trie
+	s.next = make([]int32, s.width)
+	s.out = [][]int{nil}
+
+	for id, k := range s.keywords {
+		node := 0
+
+		for i := 0; i < len(k); i++ {
+			c := int(s.classes[k[i]])
+
+			if s.next[node*s.width+c] == 0 {
+				s.next = append(s.next, make([]int32, s.width)...)
+				s.out = append(s.out, nil)
+				s.next[node*s.width+c] = int32(len(s.out) - 1)
+			}
+
+			node = int(s.next[node*s.width+c])
+		}
+
+		s.out[node] = append(s.out[node], id)
+	}
+
+	// breadth first, turn failure links into a complete transition table
+	fail := make([]int32, len(s.out))
+	queue := []int32{}
+
+	for c := 0; c < s.width; c++ {
+		if child := s.next[c]; child != 0 {
+			queue = append(queue, child)
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		s.out[node] = append(s.out[node], s.out[fail[node]]...)
+
+		for c := 0; c < s.width; c++ {
+			i := int(node)*s.width + c
+
+			if child := s.next[i]; child != 0 {
+				fail[child] = s.next[int(fail[node])*s.width+c]
+				queue = append(queue, child)
+			} else {
+				s.next[i] = s.next[int(fail[node])*s.width+c]
+			}
+		}
+	}
+}
+
+// scan returns the keywords found in the user agent, or nil when the
+// prefilter cannot be used
+func (s *keywordScanner) scan(ua string) keywordSet {
+
+	if s == nil {
+		return nil
+	}
+
+	found := make(keywordSet, (len(s.keywords)+63)/64)
+	node := 0
+
+	for i := 0; i < len(ua); i++ {
+		b := ua[i]
+
+		// unicode case folding differs from ascii lowercasing
+		if b >= 0x80 {
+			return nil
+		}
+
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+
+		node = int(s.next[node*s.width+int(s.classes[b])])
+
+		for _, id := range s.out[node] {
+			found[id/64] |= 1 << uint(id%64)
+		}
+	}
+
+	return found
+}
+
+// requiredKeywords returns lowercase keywords one of which is contained in
+// any match of the pattern, or nil if there are none. literal reports whether
+// finding a keyword is enough to know the pattern matches.
+func requiredKeywords(pattern *regexp.Regexp) (keywords []string, literal bool) {
+
+	re, err := syntax.Parse(pattern.String(), syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+
+	re = re.Simplify()
+	keywords = required(re)
+
+	return keywords, keywords != nil && pattern.NumSubexp() == 0 && literalOnly(re)
+}
+
+func required(re *syntax.Regexp) []string {
+
+	switch re.Op {
+	case syntax.OpLiteral:
+		// non ascii runes may fold onto ascii ones, as ſ does onto s
+		for _, r := range re.Rune {
+			if r >= 0x80 {
+				return nil
+			}
+		}
+
+		if len(re.Rune) > 0 {
+			return []string{strings.ToLower(string(re.Rune))}
+		}
+
+	case syntax.OpCapture, syntax.OpPlus:
+		return required(re.Sub[0])
+
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return required(re.Sub[0])
+		}
+
+	case syntax.OpConcat:
+		// prefer the keywords least likely to be found by chance
+		var best []string
+
+		for _, sub := range re.Sub {
+			if k := required(sub); k != nil && (best == nil || shortest(k) > shortest(best)) {
+				best = k
+			}
+		}
+
+		return best
+
+	case syntax.OpAlternate:
+		var all []string
+
+		for _, sub := range re.Sub {
+			k := required(sub)
+			if k == nil {
+				return nil
+			}
+
+			all = append(all, k...)
+		}
+
+		return all
+	}
+
+	return nil
+}
+
+func shortest(keywords []string) int {
+
+	n := -1
+	for _, k := range keywords {
+		if n < 0 || len(k) < n {
+			n = len(k)
+		}
+	}
+
+	return n
+}
+
+// literalOnly reports whether the pattern is a case insensitive literal or an
+// alternation of them. Only ascii literals are given keywords.
+func literalOnly(re *syntax.Regexp) bool {
+
+	switch re.Op {
+	case syntax.OpLiteral:
+		return re.Flags&syntax.FoldCase != 0
+
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if !literalOnly(sub) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
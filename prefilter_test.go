@@ -0,0 +1,67 @@
+package gopheragent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+const prefilterRules = `{
+  "version": 1,
+  "browsers": {
+    "tests": [
+      {"result": "first", "pattern": "(?i:alpha|beta)"},
+      {"result": "cased", "pattern": "Gamma/\\d+"},
+      {"result": "dotted", "pattern": "(?i:delta.epsilon)"},
+      {"result": "pattern", "pattern": "[0-9]{4}-[0-9]{2}"},
+      {"result": "kelvin", "pattern": "(?i:k9)"}
+    ],
+    "fallback": "unknown"
+  },
+  "engines": {"tests": [], "fallback": "unknown"},
+  "oses": {"tests": [], "fallback": "Unknown"},
+  "platforms": {"tests": [], "fallback": "unknown"}
+}`
+
+func Test_Prefilter_Corpus(t *testing.T) {
+
+	parser := gopheragent.NewParser(gopheragent.WithoutPrefilter())
+
+	// the prefilter only skips regexps which cannot match
+	for _, test := range testCases {
+		if got, want := gopheragent.Parse(test.UA), parser.Parse(test.UA); got != want {
+			t.Errorf("Parse[%s] => %+v; want %+v", test.UA, got, want)
+		}
+	}
+}
+
+func Test_Prefilter(t *testing.T) {
+
+	rules, err := gopheragent.LoadRules(strings.NewReader(prefilterRules))
+	if err != nil {
+		t.Fatalf("LoadRules => %v", err)
+	}
+
+	parser := gopheragent.NewParser(gopheragent.WithRules(rules))
+
+	tests := map[string]string{
+		"Gamma/1 BETA":        "first",
+		"Gamma/1 alphabet":    "first",
+		"Gamma/2":             "cased",
+		"gamma/2":             "unknown",
+		"Gamma/x":             "unknown",
+		"DELTA-EPSILON":       "dotted",
+		"delta epsilo":        "unknown",
+		"built 2014-07":       "pattern",
+		"K9 (kelvin)":         "kelvin",
+		"\u212a9 kelvin sign": "kelvin",
+		"nothing to see here": "unknown",
+	}
+
+	for ua, want := range tests {
+		if got := parser.New(ua).BrowserName(); got != want {
+			t.Errorf("UserAgent.BrowserName[%s] => %s; want %s", ua, got, want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package gopheragent
+
+// Result holds every detail extracted from a user agent. Results are plain
+// values and may be compared with ==.
+type Result struct {
+	UA              string      `json:"ua"`
+	BrowserName     string      `json:"browser_name"`
+	BrowserVersion  string      `json:"browser_version"`
+	Engine          string      `json:"engine"`
+	EngineVersion   string      `json:"engine_version"`
+	OS              string      `json:"os"`
+	OSVersion       string      `json:"os_version"`
+	OSMarketingName string      `json:"os_marketing_name,omitempty"`
+	OSFrozen        bool        `json:"os_frozen"`
+	Platform        string      `json:"platform"`
+	Arch            string      `json:"arch,omitempty"`
+	Mobile          bool        `json:"mobile"`
+	DeviceType      string      `json:"device_type"`
+	Model           string      `json:"model,omitempty"`
+	Reduced         bool        `json:"reduced"`
+	Bot             bool        `json:"bot"`
+	BotName         string      `json:"bot_name,omitempty"`
+	BotCategory     string      `json:"bot_category,omitempty"`
+	Confidence      Confidences `json:"confidence"`
+}
+
+// Parse returns the Result for the given UA string
+func Parse(ua string) Result {
+	return defaultParser.Parse(ua)
+}
+
+// Parse returns the Result for the given UA string
+func (p *Parser) Parse(ua string) Result {
+	return p.New(ua).Result()
+}
+
+// Result returns every detail of the user agent
+func (ua *UserAgent) Result() Result {
+
+	return Result{
+		UA:              ua.s,
+		BrowserName:     ua.BrowserName(),
+		BrowserVersion:  ua.BrowserVersion(),
+		Engine:          ua.Engine(),
+		EngineVersion:   ua.EngineVersion(),
+		OS:              ua.OS(),
+		OSVersion:       ua.OSVersion(),
+		OSMarketingName: ua.OSMarketingName(),
+		OSFrozen:        ua.OSFrozen(),
+		Platform:        ua.Platform(),
+		Arch:            ua.Arch(),
+		Mobile:          ua.Mobile(),
+		DeviceType:      ua.DeviceType(),
+		Model:           ua.Model(),
+		Reduced:         ua.Reduced(),
+		Bot:             ua.IsBot(),
+		BotName:         ua.BotName(),
+		BotCategory:     ua.BotCategory(),
+		Confidence:      ua.Confidence(),
+	}
+}
@@ -0,0 +1,50 @@
+package gopheragent_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_Parse(t *testing.T) {
+
+	for _, test := range testCases {
+		got := gopheragent.Parse(test.UA)
+
+		// fields not covered by the test cases are taken as is
+		want := got
+		want.UA = test.UA
+		want.BrowserName = test.BrowserName
+		want.BrowserVersion = test.BrowserVersion
+		want.Engine = test.Engine
+		want.EngineVersion = test.EngineVersion
+		want.OS = test.OS
+		want.Platform = test.Platform
+		want.Mobile = test.Mobile
+		want.DeviceType = test.DeviceType
+
+		if got != want {
+			t.Errorf("Parse[%s] => %+v; want %+v", test.UA, got, want)
+		}
+	}
+}
+
+func Test_Result_JSON(t *testing.T) {
+
+	r := gopheragent.Parse("Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36")
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal => %v", err)
+	}
+
+	var got gopheragent.Result
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal => %v", err)
+	}
+
+	if got != r {
+		t.Errorf("json round trip => %+v; want %+v", got, r)
+	}
+}
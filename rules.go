@@ -0,0 +1,308 @@
+package gopheragent
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+// RulesVersion is the rules file format understood by LoadRules
+const RulesVersion = 1
+
+//go:embed rules.json
+var defaultRulesJSON []byte
+
+type regexpTest struct {
+	Result        string
+	Pattern       *regexp.Regexp
+	Expand        bool
+	Version       []string
+	AppendVersion bool
+	keywords      []int
+	literal       bool
+}
+
+type regexpTestChain struct {
+	tests      []*regexpTest
+	fallback   string
+	families   map[string]string
+	mappedOnly bool
+}
+
+type match struct {
+	result,
+	version string
+	versioned bool
+}
+
+// Rules is a compiled set of detection rules
+type Rules struct {
+	browsers,
+	engines,
+	oses,
+	frozenOSes,
+	platforms,
+	ipadDesktopModes,
+	archs,
+	wow64,
+	devices,
+	models,
+	reduced,
+	bots regexpTestChain
+	osNames,
+	platformDevices,
+	botCategories map[string]string
+	browserVersions,
+	engineVersions map[string]*regexp.Regexp
+	mobilePlatforms []string
+	scanner         *keywordScanner
+}
+
+type testSpec struct {
+	Result        string   `json:"result"`
+	Pattern       string   `json:"pattern"`
+	Expand        bool     `json:"expand,omitempty"`
+	Version       []string `json:"version,omitempty"`
+	AppendVersion bool     `json:"append_version,omitempty"`
+}
+
+type chainSpec struct {
+	Tests    []testSpec `json:"tests"`
+	Fallback string     `json:"fallback"`
+}
+
+type botSpec struct {
+	Name     string `json:"name"`
+	Pattern  string `json:"pattern"`
+	Category string `json:"category"`
+}
+
+type rulesSpec struct {
+	Version         int               `json:"version"`
+	Browsers        chainSpec         `json:"browsers"`
+	BrowserVersions map[string]string `json:"browser_versions"`
+	Engines         chainSpec         `json:"engines"`
+	EngineVersions  map[string]string `json:"engine_versions"`
+	OSes            chainSpec         `json:"oses"`
+	OSNames         map[string]string `json:"os_names"`
+	FrozenOSes      []string          `json:"frozen_os_versions"`
+	IpadDesktopMode []string          `json:"ipad_desktop_modes"`
+	Platforms       chainSpec         `json:"platforms"`
+	Archs           chainSpec         `json:"archs"`
+	WOW64           []string          `json:"wow64"`
+	Devices         chainSpec         `json:"devices"`
+	PlatformDevices map[string]string `json:"platform_devices"`
+	Models          chainSpec         `json:"models"`
+	Reduced         []string          `json:"reduced_uas"`
+	Bots            []botSpec         `json:"bots"`
+	MobilePlatforms []string          `json:"mobile_platforms"`
+}
+
+// defaultRules are the rules embedded in the package
+var defaultRules *Rules
+
+// LoadRules reads and compiles a JSON rules file
+func LoadRules(r io.Reader) (*Rules, error) {
+
+	var spec rulesSpec
+
+	if err := json.NewDecoder(r).Decode(&spec); err != nil {
+		return nil, err
+	}
+
+	if spec.Version != RulesVersion {
+		return nil, fmt.Errorf("gopheragent: unsupported rules version %d", spec.Version)
+	}
+
+	return spec.compile()
+}
+
+// DefaultRules returns the rules used by New
+func DefaultRules() *Rules {
+	return defaultParser.rules
+}
+
+// SetRules replaces the rules used by New. It is meant to be called during
+// program initialization, before any user agents are parsed. A cache set with
+// SetDefaultParser is replaced by an empty one of the same capacity and TTL,
+// since its user agents were parsed with the previous rules.
+func SetRules(r *Rules) {
+
+	opts := []Option{WithRules(r)}
+
+	if c := defaultParser.cache; c != nil {
+		opts = append(opts, WithCache(NewCache(c.capacity, c.ttl)))
+	}
+
+	defaultParser = NewParser(opts...)
+}
+
+func (spec rulesSpec) compile() (*Rules, error) {
+
+	var (
+		rules Rules
+		err   error
+	)
+
+	chains := []struct {
+		name  string
+		spec  chainSpec
+		chain *regexpTestChain
+	}{
+		{"browsers", spec.Browsers, &rules.browsers},
+		{"engines", spec.Engines, &rules.engines},
+		{"oses", spec.OSes, &rules.oses},
+		{"platforms", spec.Platforms, &rules.platforms},
+		{"archs", spec.Archs, &rules.archs},
+		{"devices", spec.Devices, &rules.devices},
+		{"models", spec.Models, &rules.models},
+	}
+
+	for _, c := range chains {
+		if *c.chain, err = c.spec.compile(); err != nil {
+			return nil, fmt.Errorf("gopheragent: %s: %v", c.name, err)
+		}
+	}
+
+	if rules.browserVersions, err = compileVersions(rules.browsers, spec.BrowserVersions); err != nil {
+		return nil, fmt.Errorf("gopheragent: browser_versions%v", err)
+	}
+
+	if rules.engineVersions, err = compileVersions(rules.engines, spec.EngineVersions); err != nil {
+		return nil, fmt.Errorf("gopheragent: engine_versions%v", err)
+	}
+
+	rules.osNames = spec.OSNames
+
+	if rules.frozenOSes, err = compilePatterns(spec.FrozenOSes); err != nil {
+		return nil, fmt.Errorf("gopheragent: frozen_os_versions: %v", err)
+	}
+
+	if rules.ipadDesktopModes, err = compilePatterns(spec.IpadDesktopMode); err != nil {
+		return nil, fmt.Errorf("gopheragent: ipad_desktop_modes: %v", err)
+	}
+
+	if rules.wow64, err = compilePatterns(spec.WOW64); err != nil {
+		return nil, fmt.Errorf("gopheragent: wow64: %v", err)
+	}
+
+	rules.platformDevices = spec.PlatformDevices
+
+	if rules.reduced, err = compilePatterns(spec.Reduced); err != nil {
+		return nil, fmt.Errorf("gopheragent: reduced_uas: %v", err)
+	}
+
+	rules.botCategories = map[string]string{}
+	for _, bot := range spec.Bots {
+		pattern, err := regexp.Compile(bot.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("gopheragent: bots[%s]: %v", bot.Name, err)
+		}
+
+		rules.bots.tests = append(rules.bots.tests, &regexpTest{
+			Result:  bot.Name,
+			Pattern: pattern,
+		})
+		rules.botCategories[bot.Name] = bot.Category
+	}
+
+	rules.mobilePlatforms = spec.MobilePlatforms
+	rules.index()
+
+	return &rules, nil
+}
+
+func (spec chainSpec) compile() (regexpTestChain, error) {
+
+	chain := regexpTestChain{
+		tests:    make([]*regexpTest, len(spec.Tests)),
+		fallback: spec.Fallback,
+	}
+
+	for i, t := range spec.Tests {
+		pattern, err := regexp.Compile(t.Pattern)
+		if err != nil {
+			return chain, err
+		}
+
+		chain.tests[i] = &regexpTest{
+			Result:        t.Result,
+			Pattern:       pattern,
+			Expand:        t.Expand,
+			Version:       t.Version,
+			AppendVersion: t.AppendVersion,
+		}
+	}
+
+	return chain, nil
+}
+
+// compilePatterns compiles a list of patterns into a chain whose result is
+// non-empty when any of them matches
+func compilePatterns(patterns []string) (regexpTestChain, error) {
+
+	var chain regexpTestChain
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return chain, err
+		}
+
+		chain.tests = append(chain.tests, &regexpTest{
+			Result:  "match",
+			Pattern: re,
+		})
+	}
+
+	return chain, nil
+}
+
+// compileVersions compiles the version extractors for every result of the
+// chain. Results without an explicit pattern use `name[/ ]version`. Expanded
+// results cannot be known in advance and only get explicit patterns.
+func compileVersions(chain regexpTestChain, patterns map[string]string) (map[string]*regexp.Regexp, error) {
+
+	var err error
+
+	versions := make(map[string]*regexp.Regexp, len(patterns))
+
+	for name, pattern := range patterns {
+		if versions[name], err = regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("[%s]: %v", name, err)
+		}
+	}
+
+	names := []string{chain.fallback}
+	for _, test := range chain.tests {
+		if !test.Expand {
+			names = append(names, test.Result)
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := versions[name]; !ok {
+			versions[name] = defaultVersionRegexp(name)
+		}
+	}
+
+	return versions, nil
+}
+
+func defaultVersionRegexp(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i:` + regexp.QuoteMeta(name) + `[\/ ]([\d\w\.\-]+))`)
+}
+
+func init() {
+
+	rules, err := LoadRules(bytes.NewReader(defaultRulesJSON))
+	if err != nil {
+		panic(err)
+	}
+
+	defaultRules = rules
+	defaultParser = NewParser()
+}
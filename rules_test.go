@@ -0,0 +1,89 @@
+package gopheragent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+const customRules = `{
+  "version": 1,
+  "browsers": {
+    "tests": [
+      {"result": "gopher", "pattern": "(?i:gopher)"}
+    ],
+    "fallback": "unknown"
+  },
+  "browser_versions": {
+    "gopher": "(?i:gopher\\/([\\d\\.]+))"
+  },
+  "engines": {"tests": [], "fallback": "unknown"},
+  "oses": {
+    "tests": [
+      {"result": "Plan %s", "pattern": "(?i:plan (\\d+))", "expand": true}
+    ],
+    "fallback": "Unknown"
+  },
+  "platforms": {
+    "tests": [
+      {"result": "burrow", "pattern": "(?i:burrow)"}
+    ],
+    "fallback": "unknown"
+  },
+  "wow64": ["(?i:tunnel64)"],
+  "mobile_platforms": ["burrow"]
+}`
+
+func Test_LoadRules(t *testing.T) {
+
+	rules, err := gopheragent.LoadRules(strings.NewReader(customRules))
+	if err != nil {
+		t.Fatalf("LoadRules => %v", err)
+	}
+
+	defaults := gopheragent.DefaultRules()
+	gopheragent.SetRules(rules)
+	defer gopheragent.SetRules(defaults)
+
+	ua := gopheragent.New("Gopher/1.2.3 (Plan 9; Burrow; Tunnel64)")
+
+	if got := ua.BrowserName(); got != "gopher" {
+		t.Errorf("UserAgent.BrowserName => %s; want gopher", got)
+	}
+
+	if got := ua.BrowserVersion(); got != "1.2.3" {
+		t.Errorf("UserAgent.BrowserVersion => %s; want 1.2.3", got)
+	}
+
+	if got := ua.OS(); got != "Plan 9" {
+		t.Errorf("UserAgent.OS => %s; want Plan 9", got)
+	}
+
+	if got := ua.Engine(); got != gopheragent.Unknown {
+		t.Errorf("UserAgent.Engine => %s; want %s", got, gopheragent.Unknown)
+	}
+
+	if !ua.Mobile() {
+		t.Errorf("UserAgent.Mobile => false; want true")
+	}
+
+	if !ua.WOW64() {
+		t.Errorf("UserAgent.WOW64 => false; want true")
+	}
+}
+
+func Test_LoadRules_Errors(t *testing.T) {
+
+	tests := map[string]string{
+		"version": `{"version": 99}`,
+		"pattern": `{"version": 1, "browsers": {"tests": [{"result": "x", "pattern": "("}]}}`,
+		"json":    `{"version": `,
+	}
+
+	for name, rules := range tests {
+		if _, err := gopheragent.LoadRules(strings.NewReader(rules)); err == nil {
+			t.Errorf("LoadRules[%s] => nil error; want error", name)
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package gopheragent
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// uapBrowsers maps ua-parser user agent families onto browser names
+var uapBrowsers = map[string]string{
+	"Electron":                   Electron,
+	"Konqueror":                  Konqueror,
+	"Chrome":                     Chrome,
+	"Chrome Mobile":              Chrome,
+	"Chrome Mobile iOS":          Chrome,
+	"Chrome Mobile WebView":      Chrome,
+	"Chrome Frame":               Chrome,
+	"Chromium":                   Chrome,
+	"Safari":                     Safari,
+	"Mobile Safari":              Safari,
+	"Mobile Safari UI/WKWebView": Safari,
+	"Opera":                      Opera,
+	"Opera Mobile":               Opera,
+	"Opera Mini":                 Opera,
+	"Firefox":                    Firefox,
+	"Firefox Mobile":             Firefox,
+	"Firefox iOS":                Firefox,
+	"Firefox Beta":               Firefox,
+	"Firefox Alpha":              Firefox,
+	"Lotus Notes":                Lotus,
+	"Netscape":                   Netscape,
+	"SeaMonkey":                  SeaMonkey,
+	"Thunderbird":                Thunderbird,
+	"Outlook":                    Outlook,
+	"Evolution":                  Evolution,
+	"IE Mobile":                  IEMobile,
+	"IE":                         IE,
+	"Edge":                       Edge,
+	"Edge Mobile":                Edge,
+	"Samsung Internet":           Samsung,
+	"Yandex Browser":             Yandex,
+	"Vivaldi":                    Vivaldi,
+	"UC Browser":                 UCBrowser,
+	"Brave":                      Brave,
+}
+
+// uapPlatforms maps ua-parser device and os families onto platforms
+var uapPlatforms = map[string]string{
+	"iPad":                 Ipad,
+	"iPod":                 Ipod,
+	"iPod touch":           Ipod,
+	"iPhone":               Iphone,
+	"Mac":                  Mac,
+	"Mac OS X":             Mac,
+	"Nintendo Wii":         Wii,
+	"PlayStation 3":        Playstation,
+	"PlayStation 4":        Playstation,
+	"PlayStation Portable": Playstation,
+	"Windows Phone":        WindowsPhone,
+	"Windows Mobile":       WindowsPhone,
+	"Windows CE":           WindowsPhone,
+	"Windows":              Windows,
+	"Android":              Android,
+	"BlackBerry OS":        Blackberry,
+	"Symbian OS":           Symbian,
+	"Linux":                Linux,
+	"Ubuntu":               Linux,
+	"Debian":               Linux,
+	"Fedora":               Linux,
+	"Red Hat":              Linux,
+	"SUSE":                 Linux,
+	"Gentoo":               Linux,
+	"Mint":                 Linux,
+	"Slackware":            Linux,
+	"Mandriva":             Linux,
+	"CentOS":               Linux,
+}
+
+var uapReference = regexp.MustCompile(`\$(\d)`)
+
+// LoadUAPRegexes reads a ua-parser (uap-core) regexes.yaml file and compiles
+// it into rules. User agent parsers provide the browser name and version, os
+// parsers the OS, and device parsers followed by os parsers the platform.
+// Families are mapped onto the package constants where possible. Engines,
+// architectures, devices, models, bots and mobile platforms are taken from
+// the default rules.
+func LoadUAPRegexes(r io.Reader) (*Rules, error) {
+
+	doc, err := parseUAPYAML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := Rules{
+		engines:          defaultRules.engines.clone(),
+		engineVersions:   defaultRules.engineVersions,
+		frozenOSes:       defaultRules.frozenOSes.clone(),
+		ipadDesktopModes: defaultRules.ipadDesktopModes.clone(),
+		archs:            defaultRules.archs.clone(),
+		devices:          defaultRules.devices.clone(),
+		platformDevices:  defaultRules.platformDevices,
+		models:           defaultRules.models.clone(),
+		reduced:          defaultRules.reduced.clone(),
+		wow64:            defaultRules.wow64.clone(),
+		bots:             defaultRules.bots.clone(),
+		botCategories:    defaultRules.botCategories,
+		mobilePlatforms:  defaultRules.mobilePlatforms,
+	}
+
+	rules.browsers = regexpTestChain{
+		fallback: Unknown,
+		families: uapBrowsers,
+	}
+
+	for _, p := range doc["user_agent_parsers"] {
+		test, err := newUAPTest(p, "family_replacement", []string{
+			"v1_replacement",
+			"v2_replacement",
+			"v3_replacement",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("gopheragent: user_agent_parsers: %v", err)
+		}
+
+		rules.browsers.tests = append(rules.browsers.tests, test)
+	}
+
+	// versions are extracted by the parsers themselves
+	rules.browserVersions, _ = compileVersions(rules.browsers, nil)
+
+	rules.oses = regexpTestChain{
+		fallback: "Unknown",
+	}
+
+	for _, p := range doc["os_parsers"] {
+		test, err := newUAPTest(p, "os_replacement", []string{
+			"os_v1_replacement",
+			"os_v2_replacement",
+			"os_v3_replacement",
+			"os_v4_replacement",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("gopheragent: os_parsers: %v", err)
+		}
+
+		test.AppendVersion = true
+		rules.oses.tests = append(rules.oses.tests, test)
+	}
+
+	rules.platforms = regexpTestChain{
+		fallback:   Unknown,
+		families:   uapPlatforms,
+		mappedOnly: true,
+	}
+
+	for _, p := range doc["device_parsers"] {
+		test, err := newUAPTest(p, "device_replacement", nil)
+		if err != nil {
+			return nil, fmt.Errorf("gopheragent: device_parsers: %v", err)
+		}
+
+		rules.platforms.tests = append(rules.platforms.tests, test)
+	}
+
+	for _, test := range rules.oses.tests {
+		rules.platforms.tests = append(rules.platforms.tests, &regexpTest{
+			Result:  test.Result,
+			Pattern: test.Pattern,
+			Expand:  test.Expand,
+		})
+	}
+
+	rules.index()
+
+	return &rules, nil
+}
+
+// newUAPTest converts a ua-parser entry into a test. Replacements default to
+// the capture group following the previous one, as in ua-parser.
+func newUAPTest(p map[string]string, result string, versions []string) (*regexpTest, error) {
+
+	pattern := p["regex"]
+	if strings.Contains(p["regex_flag"], "i") {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	test := regexpTest{
+		Pattern: re,
+	}
+
+	test.Result, test.Expand = uapFormat(p, result, 1, re.NumSubexp())
+
+	for i, key := range versions {
+		v, _ := uapFormat(p, key, i+2, re.NumSubexp())
+		test.Version = append(test.Version, v)
+	}
+
+	return &test, nil
+}
+
+// uapFormat converts a ua-parser replacement into a format string for the
+// test submatches, reporting whether it refers to any of them
+func uapFormat(p map[string]string, key string, group, groups int) (string, bool) {
+
+	replacement, ok := p[key]
+	if !ok {
+		if group > groups {
+			return "", false
+		}
+
+		return fmt.Sprintf("%%[%d]s", group), true
+	}
+
+	if !uapReference.MatchString(replacement) {
+		return replacement, false
+	}
+
+	replacement = strings.Replace(replacement, "%", "%%", -1)
+
+	return uapReference.ReplaceAllStringFunc(replacement, func(ref string) string {
+		n, _ := strconv.Atoi(ref[1:])
+		if n < 1 || n > groups {
+			return ""
+		}
+
+		return fmt.Sprintf("%%[%d]s", n)
+	}), true
+}
+
+// parseUAPYAML reads the subset of YAML used by regexes.yaml: top level keys
+// holding lists of flat maps with scalar values
+func parseUAPYAML(r io.Reader) (map[string][]map[string]string, error) {
+
+	var (
+		doc     = map[string][]map[string]string{}
+		section string
+		item    map[string]string
+		line    int
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(text)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+
+		// top level section
+		if text[0] != ' ' && text[0] != '-' {
+			key, value, err := splitYAMLPair(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("gopheragent: yaml line %d: %v", line, err)
+			}
+
+			if value != "" {
+				continue
+			}
+
+			section, item = key, nil
+			continue
+		}
+
+		if section == "" {
+			return nil, fmt.Errorf("gopheragent: yaml line %d: entry outside of a section", line)
+		}
+
+		if strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+			item = map[string]string{}
+			doc[section] = append(doc[section], item)
+			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "-"))
+
+			if trimmed == "" {
+				continue
+			}
+		}
+
+		if item == nil {
+			return nil, fmt.Errorf("gopheragent: yaml line %d: expected a list entry", line)
+		}
+
+		key, value, err := splitYAMLPair(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("gopheragent: yaml line %d: %v", line, err)
+		}
+
+		item[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func splitYAMLPair(s string) (key, value string, err error) {
+
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return "", "", fmt.Errorf("expected key: value, got %q", s)
+	}
+
+	key = strings.TrimSpace(s[:i])
+	value, err = yamlScalar(strings.TrimSpace(s[i+1:]))
+
+	return key, value, err
+}
+
+func yamlScalar(s string) (string, error) {
+
+	if s == "" {
+		return "", nil
+	}
+
+	switch s[0] {
+	case '\'':
+		var b strings.Builder
+
+		for i := 1; i < len(s); i++ {
+			if s[i] != '\'' {
+				b.WriteByte(s[i])
+				continue
+			}
+
+			// a doubled quote is an escaped quote
+			if i+1 < len(s) && s[i+1] == '\'' {
+				b.WriteByte('\'')
+				i++
+				continue
+			}
+
+			return b.String(), nil
+		}
+
+		return "", fmt.Errorf("unterminated string %s", s)
+
+	case '"':
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\\' {
+				i++
+				continue
+			}
+
+			if s[i] == '"' {
+				return strconv.Unquote(s[:i+1])
+			}
+		}
+
+		return "", fmt.Errorf("unterminated string %s", s)
+	}
+
+	// strip trailing comments from plain scalars
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = strings.TrimSpace(s[:i])
+	}
+
+	return s, nil
+}
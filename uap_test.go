@@ -0,0 +1,179 @@
+package gopheragent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+const uapRegexes = `
+user_agent_parsers:
+  # Firefox pre-releases
+  - regex: '(Namoroka|Shiretoko|Minefield)/(\d+)\.(\d+)\.(\d+(?:pre|))'
+    family_replacement: 'Firefox ($1)'
+
+  - regex: '(CriOS)/(\d+)\.(\d+)\.(\d+)\.(\d+)'
+    family_replacement: 'Chrome Mobile iOS'
+
+  - regex: '(FxiOS)/(\d+)\.(\d+)(?:\.(\d+)|)'
+    family_replacement: 'Firefox iOS'
+
+  - regex: '(Chrome)/(\d+)\.(\d+)\.(\d+)\.(\d+) Mobile(?:[ /]|$)'
+    family_replacement: 'Chrome Mobile'
+
+  - regex: '(Chrome)/(\d+)\.(\d+)\.(\d+)\.(\d+)'
+
+  - regex: '(Version)/(\d+)\.(\d+)(?:\.(\d+)|).*Safari/'
+    family_replacement: 'Safari'
+
+  - regex: 'rv:(\d+)\.(\d+)\.?(\d+)?.*Firefox'
+    family_replacement: "Firefox"
+    v1_replacement: '$1'
+    v2_replacement: '$2'
+    v3_replacement: '$3'
+
+os_parsers:
+  - regex: '(Windows NT 6\.1)'
+    os_replacement: 'Windows'
+    os_v1_replacement: '7'
+
+  - regex: '(Android)[ \-/](\d+)(?:\.(\d+)|)(?:[.\-]([a-z0-9]+)|)'
+
+  - regex: '(CPU[ +]OS|iPhone[ +]OS|CPU[ +]iPhone|CPU IPhone OS)[ +]+(\d+)[_\.](\d+)(?:[_\.](\d+)|)'
+    os_replacement: 'iOS'
+
+  - regex: 'Mac OS X (\d+)[_.](\d+)(?:[_.](\d+)|)'
+    os_replacement: 'Mac OS X'
+    os_v1_replacement: '$1'
+    os_v2_replacement: '$2'
+    os_v3_replacement: '$3'
+
+device_parsers:
+  - regex: '(iPhone|iPad|iPod)(\d+,\d+)'
+    device_replacement: '$1'
+
+  - regex: '(iPad)(?:;| Simulator;)'
+    device_replacement: 'iPad'
+
+  - regex: '(iPhone)(?:;| Simulator;)'
+    device_replacement: 'iPhone'
+
+  - regex: '; *(SM-[A-Z0-9]+)'
+    regex_flag: 'i'
+    device_replacement: 'Samsung $1'
+`
+
+type uapTestCase struct {
+	UA,
+	BrowserName,
+	BrowserVersion,
+	OS,
+	Platform string
+	Mobile bool
+}
+
+func Test_LoadUAPRegexes(t *testing.T) {
+
+	rules, err := gopheragent.LoadUAPRegexes(strings.NewReader(uapRegexes))
+	if err != nil {
+		t.Fatalf("LoadUAPRegexes => %v", err)
+	}
+
+	parser := gopheragent.NewParser(gopheragent.WithRules(rules))
+
+	tests := []uapTestCase{
+		{
+			UA:             "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
+			BrowserName:    "chrome",
+			BrowserVersion: "36.0.1985",
+			OS:             "Windows 7",
+			Platform:       "windows",
+		},
+		{
+			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SM-T230NU Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
+			BrowserName:    "chrome",
+			BrowserVersion: "36.0.1985",
+			OS:             "Android 4.4.2",
+			Platform:       "android",
+			Mobile:         true,
+		},
+		{
+			UA:             "Mozilla/5.0 (iPad; CPU OS 7_1_2 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) Version/7.0 Mobile/11D257 Safari/9537.53",
+			BrowserName:    "safari",
+			BrowserVersion: "7.0",
+			OS:             "iOS 7.1.2",
+			Platform:       "ipad",
+			Mobile:         true,
+		},
+		{
+			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 7_1 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) CriOS/36.0.1985.49 Mobile/11D167 Safari/9537.53",
+			BrowserName:    "chrome",
+			BrowserVersion: "36.0.1985",
+			OS:             "iOS 7.1",
+			Platform:       "iphone",
+			Mobile:         true,
+		},
+		{
+			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 8_3 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) FxiOS/1.0 Mobile/12F69 Safari/600.1.4",
+			BrowserName:    "firefox",
+			BrowserVersion: "1.0",
+			OS:             "iOS 8.3",
+			Platform:       "iphone",
+			Mobile:         true,
+		},
+		{
+			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.9; rv:31.0) Gecko/20100101 Firefox/31.0",
+			BrowserName:    "firefox",
+			BrowserVersion: "31.0",
+			OS:             "Mac OS X 10.9",
+			Platform:       "macintosh",
+		},
+		{
+			UA:             "Mozilla/5.0 (X11; U; Linux i686; en-US; rv:1.9.2a1pre) Gecko/20090405 Minefield/3.6.0pre",
+			BrowserName:    "Firefox (Minefield)",
+			BrowserVersion: "3.6.0pre",
+			OS:             "Unknown",
+			Platform:       "unknown",
+		},
+	}
+
+	for _, test := range tests {
+		ua := parser.New(test.UA)
+
+		if got := ua.BrowserName(); got != test.BrowserName {
+			t.Errorf("UserAgent.BrowserName[%s] => %s; want %s", test.UA, got, test.BrowserName)
+		}
+
+		if got := ua.BrowserVersion(); got != test.BrowserVersion {
+			t.Errorf("UserAgent.BrowserVersion[%s] => %s; want %s", test.UA, got, test.BrowserVersion)
+		}
+
+		if got := ua.OS(); got != test.OS {
+			t.Errorf("UserAgent.OS[%s] => %s; want %s", test.UA, got, test.OS)
+		}
+
+		if got := ua.Platform(); got != test.Platform {
+			t.Errorf("UserAgent.Platform[%s] => %s; want %s", test.UA, got, test.Platform)
+		}
+
+		if got := ua.Mobile(); got != test.Mobile {
+			t.Errorf("UserAgent.Mobile[%s] => %t; want %t", test.UA, got, test.Mobile)
+		}
+	}
+}
+
+func Test_LoadUAPRegexes_Errors(t *testing.T) {
+
+	tests := map[string]string{
+		"pattern": "user_agent_parsers:\n  - regex: '('\n",
+		"quote":   "user_agent_parsers:\n  - regex: '(Chrome)\n",
+		"section": "  - regex: 'Chrome'\n",
+	}
+
+	for name, yaml := range tests {
+		if _, err := gopheragent.LoadUAPRegexes(strings.NewReader(yaml)); err == nil {
+			t.Errorf("LoadUAPRegexes[%s] => nil error; want error", name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // Browsers
@@ -24,15 +25,63 @@ const (
 	Evolution   = "evolution"
 	IEMobile    = "iemobile"
 	IE          = "ie"
+	Edge        = "edge"
+	EdgeLegacy  = "edge_legacy"
+	Samsung     = "samsung"
+	Yandex      = "yandex"
+	Vivaldi     = "vivaldi"
+	UCBrowser   = "ucbrowser"
+	Brave       = "brave"
 )
 
 // Engines
 const (
-	Webkit = "webkit"
-	Khtml  = "khtml"
-	Presto = "presto"
-	Gecko  = "gecko"
-	Msie   = "msie"
+	Webkit   = "webkit"
+	Khtml    = "khtml"
+	Presto   = "presto"
+	Gecko    = "gecko"
+	Msie     = "msie"
+	Trident  = "trident" // IE 11, earlier versions being reported as msie
+	EdgeHTML = "edgehtml"
+	Blink    = "blink"
+)
+
+// Bot categories
+const (
+	BotSearchEngine  = "search_engine"
+	BotSEOCrawler    = "seo_crawler"
+	BotLinkPreviewer = "link_previewer"
+	BotMonitoring    = "monitoring"
+	BotFeedFetcher   = "feed_fetcher"
+	BotAICrawler     = "ai_crawler"
+
+	// BotGeneric is the category of bots only told by tokens such as bot,
+	// crawler or spider
+	BotGeneric = "generic"
+)
+
+// GenericBot is the name of bots only told by tokens such as bot, crawler or
+// spider
+const GenericBot = "Generic Bot"
+
+// Architectures
+const (
+	ArchX86   = "x86"
+	ArchX64   = "x64"
+	ArchARM   = "arm"
+	ArchARM64 = "arm64"
+)
+
+// Device types
+const (
+	DeviceDesktop  = "desktop"
+	DevicePhone    = "phone"
+	DeviceTablet   = "tablet"
+	DeviceTV       = "tv"
+	DeviceConsole  = "console"
+	DeviceWearable = "wearable"
+	DeviceEmbedded = "embedded"
+	DeviceBot      = "bot"
 )
 
 // Platforms
@@ -54,48 +103,67 @@ const (
 // Unknown is returned when a result cannot be extracted
 const Unknown = "unknown"
 
-type regexpTest struct {
-	Result  string
-	Pattern *regexp.Regexp
-	Expand  bool
-}
-type regexpTestChain struct {
-	tests    []*regexpTest
-	fallback string
-}
-
-var browsers,
-	engines,
-	oses,
-	platforms regexpTestChain
-var browserVersions map[string]*regexp.Regexp
-var mobilePlatforms []string
-
-// UserAgent provides methods for extracting UA details
+// UserAgent provides methods for extracting UA details. Results are
+// computed lazily and a UserAgent is safe for concurrent use.
 type UserAgent struct {
+	rules *Rules
+	hints Hints
 	s,
 	browser,
+	browserVersion,
 	engine,
 	os,
-	platform string
+	osVersion,
+	platform,
+	arch,
+	device,
+	model,
+	bot string
+	browserVersioned,
+	browserRefined,
+	osRefined,
+	platformInferred bool
+	found keywordSet
+
+	scanOnce,
+	browserOnce,
+	engineOnce,
+	osOnce,
+	platformOnce,
+	archOnce,
+	deviceOnce,
+	modelOnce,
+	botOnce sync.Once
 }
 
 // New returns a UserAgent for the given UA string
 func New(ua string) *UserAgent {
-
-	result := UserAgent{
-		s: strings.TrimSpace(ua),
-	}
-
-	return &result
+	return defaultParser.New(ua)
 }
 
 // BrowserName returns the name of the browser from the user agent
 func (ua *UserAgent) BrowserName() string {
 
-	if ua.browser == "" {
-		ua.browser = matchFirst(browsers, ua.s)
-	}
+	ua.browserOnce.Do(func() {
+		m := ua.rules.browsers.match(ua.s, ua.keywords())
+		ua.browser = m.result
+		ua.browserVersion = m.version
+		ua.browserVersioned = m.versioned
+
+		if len(ua.hints.Brands) == 0 && len(ua.hints.FullVersionList) == 0 {
+			return
+		}
+
+		version := ua.browserVersion
+		if !ua.browserVersioned {
+			version = firstSubmatch(ua.rules.browserVersions[ua.browser], ua.s)
+		}
+
+		if name, version, ok := ua.hints.refineBrowser(ua.browser, version, ua.Reduced()); ok {
+			ua.browser, ua.browserVersion, ua.browserVersioned = name, version, true
+			ua.browserRefined = true
+		}
+	})
 
 	return ua.browser
 
@@ -104,24 +172,22 @@ func (ua *UserAgent) BrowserName() string {
 // BrowserVersion returns the version of the browser from the user agent
 func (ua *UserAgent) BrowserVersion() string {
 
-	r, err := browserVersionRegexp(ua.BrowserName())
-	if err == nil {
-		matches := r.FindStringSubmatch(ua.s)
+	browser := ua.BrowserName()
 
-		if len(matches) > 1 {
-			return matches[1]
-		}
+	// rules extracting the version along with the name take precedence
+	if ua.browserVersioned {
+		return ua.browserVersion
 	}
 
-	return ""
+	return firstSubmatch(ua.rules.browserVersions[browser], ua.s)
 }
 
 // Engine returns the rendering engine from the user agent
 func (ua *UserAgent) Engine() string {
 
-	if ua.engine == "" {
-		ua.engine = matchFirst(engines, ua.s)
-	}
+	ua.engineOnce.Do(func() {
+		ua.engine = matchFirst(ua.rules.engines, ua.s, ua.keywords())
+	})
 
 	return ua.engine
 
@@ -130,49 +196,182 @@ func (ua *UserAgent) Engine() string {
 // EngineVersion returns the version of the rendering engine used
 func (ua *UserAgent) EngineVersion() string {
 
-	engineVersion, err := regexp.Compile(`(?i:` + ua.Engine() + `[\/ ]([\d\w\.\-]+))`)
+	return firstSubmatch(ua.rules.engineVersions[ua.Engine()], ua.s)
 
-	if err == nil {
-		matches := engineVersion.FindStringSubmatch(ua.s)
+}
 
-		if len(matches) > 1 {
-			return matches[1]
+// OS returns the operating system from the user agent
+func (ua *UserAgent) OS() string {
+
+	ua.osOnce.Do(func() {
+		m := ua.rules.oses.match(ua.s, ua.keywords())
+
+		// the macOS version of a desktop mode iPad says nothing of iPadOS
+		if ua.Platform() == Ipad && ua.platformInferred {
+			m = match{result: "iPadOS"}
 		}
 
-	}
+		ua.os, ua.osVersion, ua.osRefined = ua.hints.refineOS(m.result, m.version)
+	})
 
-	return ""
+	return ua.os
 
 }
 
-// OS returns the operating system from the user agent
-func (ua *UserAgent) OS() string {
+// OSVersion returns the version of the operating system from the user agent,
+// e.g. "4.4.2" for "Android 4.4.2"
+func (ua *UserAgent) OSVersion() string {
 
-	if ua.os == "" {
-		ua.os = matchFirst(oses, ua.s)
-	}
+	ua.OS()
 
-	return ua.os
+	return ua.osVersion
+
+}
 
+// OSMarketingName returns the marketing name of the operating system from
+// the user agent, e.g. "Catalina" for "macOS 10.15", or an empty string
+func (ua *UserAgent) OSMarketingName() string {
+	return ua.rules.osNames[ua.OS()]
 }
 
-// Platform returns the platform from the user agent
+// OSFrozen returns true if the operating system version comes from a UA
+// string known to be frozen, such as Safari reporting macOS 10.15.7 whatever
+// the actual version, Windows 11 reporting Windows NT 10.0 or a reduced UA
+// string, and was not refined by client hints
+func (ua *UserAgent) OSFrozen() bool {
+
+	ua.OS()
+
+	return !ua.osRefined && (matchFirst(ua.rules.frozenOSes, ua.s, ua.keywords()) != "" || ua.Reduced())
+
+}
+
+// OSVersionParts returns the major, minor and patch components of the version
+// of the operating system. Missing components are 0.
+func (ua *UserAgent) OSVersionParts() (major, minor, patch int) {
+
+	v := ua.OSVersionInfo()
+
+	return v.Major, v.Minor, v.Patch
+}
+
+// Platform returns the platform from the user agent. iPads in desktop mode
+// are recognized from iOS only browsers, or from a MaxTouchPoints hint.
 func (ua *UserAgent) Platform() string {
 
-	if ua.platform == "" {
-		ua.platform = matchFirst(platforms, ua.s)
-	}
+	ua.platformOnce.Do(func() {
+		ua.platform = matchFirst(ua.rules.platforms, ua.s, ua.keywords())
+
+		if platform, ok := hintPlatforms[ua.hints.Platform]; ok {
+			ua.platform = platform
+		}
+
+		// iPads request desktop sites with a Macintosh UA string
+		if ua.platform == Mac && (ua.hints.MaxTouchPoints > 1 ||
+			matchFirst(ua.rules.ipadDesktopModes, ua.s, ua.keywords()) != "") {
+			ua.platform = Ipad
+			ua.platformInferred = true
+		}
+	})
 
 	return ua.platform
 
 }
 
-// Mobile returns true if the user agent represents a mobile client
+// Arch returns the CPU architecture of the operating system from the user
+// agent, or an empty string if it is not mentioned
+func (ua *UserAgent) Arch() string {
+
+	ua.archOnce.Do(func() {
+		ua.arch = ua.hints.refineArch(matchFirst(ua.rules.archs, ua.s, ua.keywords()))
+	})
+
+	return ua.arch
+
+}
+
+// WOW64 returns true if the user agent represents a 32-bit Windows browser
+// running on 64-bit Windows
+func (ua *UserAgent) WOW64() bool {
+	return matchFirst(ua.rules.wow64, ua.s, ua.keywords()) != ""
+}
+
+// DeviceType returns the type of device from the user agent, derived from
+// device tokens or else from the platform
+func (ua *UserAgent) DeviceType() string {
+
+	ua.deviceOnce.Do(func() {
+		if ua.IsBot() {
+			ua.device = DeviceBot
+			return
+		}
+
+		// desktop mode iPads claim to be Macs, yet still send Mobile tokens
+		if ua.Platform() == Ipad && ua.platformInferred {
+			ua.device = DeviceTablet
+			return
+		}
+
+		ua.device = matchFirst(ua.rules.devices, ua.s, ua.keywords())
+		if ua.device != "" {
+			return
+		}
+
+		var ok bool
+		if ua.device, ok = ua.rules.platformDevices[ua.Platform()]; !ok {
+			ua.device = Unknown
+		}
+	})
+
+	return ua.device
+
+}
+
+// Tablet returns true if the user agent represents a tablet
+func (ua *UserAgent) Tablet() bool {
+	return ua.DeviceType() == DeviceTablet
+}
+
+// Desktop returns true if the user agent represents a desktop computer
+func (ua *UserAgent) Desktop() bool {
+	return ua.DeviceType() == DeviceDesktop
+}
+
+// IsBot returns true if the user agent represents a bot, crawler or spider
+func (ua *UserAgent) IsBot() bool {
+	return ua.BotName() != ""
+}
+
+// BotName returns the name of the bot from the user agent, or an empty string
+// if it is not a bot
+func (ua *UserAgent) BotName() string {
+
+	ua.botOnce.Do(func() {
+		ua.bot = matchFirst(ua.rules.bots, ua.s, ua.keywords())
+	})
+
+	return ua.bot
+
+}
+
+// BotCategory returns the category of the bot from the user agent, or an
+// empty string if it is not a bot
+func (ua *UserAgent) BotCategory() string {
+	return ua.rules.botCategories[ua.BotName()]
+}
+
+// Mobile returns true if the user agent represents a mobile client, tablets
+// included. The Sec-CH-UA-Mobile hint may only tell a client is mobile, as
+// Android tablets send ?0 whereas iPads are mobile platforms too.
 func (ua *UserAgent) Mobile() bool {
 
+	if ua.hints.Platform != "" && ua.hints.Mobile {
+		return true
+	}
+
 	platform := ua.Platform()
 
-	for _, t := range mobilePlatforms {
+	for _, t := range ua.rules.mobilePlatforms {
 		if t == platform {
 			return true
 		}
@@ -182,147 +381,111 @@ func (ua *UserAgent) Mobile() bool {
 
 }
 
-func browserVersionRegexp(b string) (r *regexp.Regexp, err error) {
+func firstSubmatch(r *regexp.Regexp, s string) string {
 
-	r, ok := browserVersions[b]
-	if !ok {
-		r, err = regexp.Compile(
-			`(?i:` + b + `[\/ ]([\d\w\.\-]+))`,
-		)
+	if r != nil {
+		matches := r.FindStringSubmatch(s)
+
+		if len(matches) > 1 {
+			return matches[1]
+		}
 	}
 
-	return r, err
+	return ""
 }
 
-func matchFirst(tt regexpTestChain, ua string) string {
+// keywords returns the rule keywords found in the user agent
+func (ua *UserAgent) keywords() keywordSet {
 
-	for _, test := range tt.tests {
-		if m := test.Pattern.FindStringSubmatch(ua); m != nil {
+	ua.scanOnce.Do(func() {
+		ua.found = ua.rules.scanner.scan(ua.s)
+	})
+
+	return ua.found
+}
+
+func matchFirst(tt regexpTestChain, ua string, found keywordSet) string {
+	return tt.match(ua, found).result
+}
 
-			// see if we need to expand the result
-			if test.Expand && len(m) > 1 {
-				submatches := m[1:]
-				args := make([]interface{}, len(submatches))
+func (tt regexpTestChain) match(ua string, found keywordSet) match {
 
-				for i, v := range submatches {
-					args[i] = interface{}(v)
-				}
+	for _, test := range tt.tests {
+		if !test.candidate(found) {
+			continue
+		}
 
-				return fmt.Sprintf(test.Result, args...)
+		// literal tests match as soon as one of their keywords is found
+		m := []string{""}
+		if !test.literal || found == nil {
+			if m = test.Pattern.FindStringSubmatch(ua); m == nil {
+				continue
 			}
+		}
+
+		result := test.result(m)
 
-			return test.Result
+		// map the result onto a known name, skipping unmapped results if
+		// the chain only accepts known names
+		if name, ok := tt.families[result]; ok {
+			result = name
+		} else if tt.mappedOnly {
+			continue
 		}
+
+		res := match{result: result}
+
+		if test.Version != nil {
+			res.version = test.version(m)
+			res.versioned = true
+
+			if test.AppendVersion && res.version != "" {
+				res.result += " " + res.version
+			}
+		}
+
+		return res
 	}
 
-	return tt.fallback
+	return match{result: tt.fallback}
 }
 
-func newRegexpTest(result, pattern string, expand bool) *regexpTest {
-	return &regexpTest{
-		Result:  result,
-		Pattern: regexp.MustCompile(pattern),
-		Expand:  expand,
+func (test *regexpTest) result(m []string) string {
+
+	// see if we need to expand the result
+	if test.Expand && len(m) > 1 {
+		return sprintf(test.Result, m[1:])
 	}
-}
 
-func newSimpleTest(result, pattern string) *regexpTest {
-	return newRegexpTest(result, pattern, false)
+	return test.Result
 }
 
-func init() {
-
-	browsers = regexpTestChain{
-		tests: []*regexpTest{
-			newSimpleTest(Electron, `(?i:electron)`),
-			newSimpleTest(Konqueror, `(?i:konqueror)`),
-			newSimpleTest(Chrome, `(?i:chrome)`),
-			newSimpleTest(Safari, `(?i:safari)`),
-			newSimpleTest(Opera, `(?i:opera)`),
-			newSimpleTest(PS3, `(?i:playstation 3)`),
-			newSimpleTest(PSP, `(?i:playstation portable)`),
-			newSimpleTest(Firefox, `(?i:firefox)`),
-			newSimpleTest(Lotus, `(?i:lotus.notes)`),
-			newSimpleTest(Netscape, `(?i:netscape)`),
-			newSimpleTest(SeaMonkey, `(?i:seamonkey)`),
-			newSimpleTest(Thunderbird, `(?i:thunderbird)`),
-			newSimpleTest(Outlook, `(?i:microsoft.outlook)`),
-			newSimpleTest(Evolution, `(?i:evolution)`),
-			newSimpleTest(IEMobile, `(?i:iemobile|windows phone)`),
-			newSimpleTest(IE, `(?i:msie)`),
-		},
-		fallback: Unknown,
-	}
+func (test *regexpTest) version(m []string) string {
 
-	browserVersions = map[string]*regexp.Regexp{
-		Electron: regexp.MustCompile(`(?i:electron\/([\d\w\.\-]+))`),
-		Chrome:   regexp.MustCompile(`(?i:chrome\/([\d\w\.\-]+))`),
-		Safari:   regexp.MustCompile(`(?i:version\/([\d\w\.\-]+))`),
-		PS3:      regexp.MustCompile(`(?i:([\d\w\.\-]+)\)\s*$)`),
-		PSP:      regexp.MustCompile(`(?i:([\d\w\.\-]+)\)?\s*$)`),
-		Lotus:    regexp.MustCompile(`(?i:Lotus-Notes\/([\w.]+))`),
-	}
+	parts := make([]string, 0, len(test.Version))
 
-	engines = regexpTestChain{
-		tests: []*regexpTest{
-			newSimpleTest(Webkit, `(?i:webkit)`),
-			newSimpleTest(Khtml, `(?i:khtml)`),
-			newSimpleTest(Konqueror, `(?i:konqueror)`),
-			newSimpleTest(Chrome, `(?i:chrome)`),
-			newSimpleTest(Presto, `(?i:presto)`),
-			newSimpleTest(Gecko, `(?i:gecko)`),
-			newSimpleTest(Unknown, `(?i:opera)`),
-			newSimpleTest(Msie, `(?i:msie)`),
-		},
-		fallback: Unknown,
-	}
+	for _, v := range test.Version {
+		if strings.Contains(v, "%") {
+			v = sprintf(v, m[1:])
+		}
 
-	oses = regexpTestChain{
-		tests: []*regexpTest{
-			newSimpleTest("Windows Phone", `(?i:windows (ce|phone|mobile)( os)?)`),
-			newSimpleTest("Windows Vista", `(?i:windows nt 6\.0)`),
-			newSimpleTest("Windows 7", `(?i:windows nt 6\.\d+)`),
-			newSimpleTest("Windows 2003", `(?i:windows nt 5\.2)`),
-			newSimpleTest("Windows XP", `(?i:windows nt 5\.1)`),
-			newSimpleTest("Windows 2000", `(?i:windows nt 5\.0)`),
-			newSimpleTest("Windows", `(?i:windows)`),
-			newRegexpTest("OS X %s.%s", `(?i:os x (\d+)[._](\d+))`, true),
-			newSimpleTest("Linux", `(?i:linux)`),
-			newSimpleTest("Wii", `(?i:wii)`),
-			newSimpleTest("Playstation", `(?i:playstation 3)`),
-			newSimpleTest("Playstation", `(?i:playstation portable)`),
-			newRegexpTest("iPad OS %s.%s", `(?i:\(iPad.*os (\d+)[._](\d+))`, true),
-			newRegexpTest("iPhone OS %s.%s", `(?i:\(iPhone.*os (\d+)[._](\d+))`, true),
-			newSimpleTest("Symbian OS", `(?i:symbian(os)?)`),
-		},
-		fallback: "Unknown",
-	}
+		if v == "" {
+			break
+		}
 
-	platforms = regexpTestChain{
-		tests: []*regexpTest{
-			newSimpleTest(WindowsPhone, `(?i:windows (ce|phone|mobile)( os)?)`),
-			newSimpleTest(Windows, `(?i:windows)`),
-			newSimpleTest(Mac, `(?i:macintosh)`),
-			newSimpleTest(Android, `(?i:android)`),
-			newSimpleTest(Blackberry, `(?i:blackberry)`),
-			newSimpleTest(Linux, `(?i:linux)`),
-			newSimpleTest(Wii, `(?i:wii)`),
-			newSimpleTest(Playstation, `(?i:playstation)`),
-			newSimpleTest(Ipad, `(?i:ipad)`),
-			newSimpleTest(Ipod, `(?i:ipod)`),
-			newSimpleTest(Iphone, `(?i:iphone)`),
-			newSimpleTest(Symbian, `(?i:symbian(os)?)`),
-		},
-		fallback: Unknown,
+		parts = append(parts, v)
 	}
 
-	mobilePlatforms = []string{
-		Android,
-		Blackberry,
-		Ipad,
-		Ipod,
-		Iphone,
-		Symbian,
-		WindowsPhone,
+	return strings.Join(parts, ".")
+}
+
+func sprintf(format string, submatches []string) string {
+
+	args := make([]interface{}, len(submatches))
+
+	for i, v := range submatches {
+		args[i] = interface{}(v)
 	}
+
+	return fmt.Sprintf(format, args...)
 }
@@ -1,9 +1,10 @@
 package gopheragent_test
 
 import (
+	"sync"
 	"testing"
 
-	"."
+	"github.com/remind101/gopheragent"
 )
 
 type UserAgentTestCase struct {
@@ -14,7 +15,8 @@ type UserAgentTestCase struct {
 	EngineVersion,
 	OS,
 	Platform string
-	Mobile bool
+	Mobile     bool
+	DeviceType string
 }
 
 var testCases []UserAgentTestCase
@@ -87,6 +89,293 @@ func Test_UserAgent_Parse(t *testing.T) {
 			)
 		}
 
+		// device type
+		if got := ua.DeviceType(); got != test.DeviceType {
+			t.Errorf("UserAgent.DeviceType[%s] => %s; want %s",
+				test.UA,
+				got,
+				test.DeviceType,
+			)
+		}
+
+		if got := ua.Tablet(); got != (test.DeviceType == gopheragent.DeviceTablet) {
+			t.Errorf("UserAgent.Tablet[%s] => %t; want %t", test.UA, got, !got)
+		}
+
+		if got := ua.Desktop(); got != (test.DeviceType == gopheragent.DeviceDesktop) {
+			t.Errorf("UserAgent.Desktop[%s] => %t; want %t", test.UA, got, !got)
+		}
+
+	}
+}
+
+func Test_UserAgent_OSVersion(t *testing.T) {
+
+	tests := []struct {
+		UA, OS, Version     string
+		Major, Minor, Patch int
+	}{
+		{
+			UA:      "Mozilla/5.0 (Linux; Android 13; Pixel 7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/116.0.0.0 Mobile Safari/537.36",
+			OS:      "Android 13",
+			Version: "13",
+			Major:   13,
+		},
+		{
+			UA:      "Dalvik/1.6.0 (Linux; U; Android 4.2.2; 2013023 MIUI/JHBMIBF18.0)",
+			OS:      "Android 4.2.2",
+			Version: "4.2.2",
+			Major:   4, Minor: 2, Patch: 2,
+		},
+		{
+			UA:      "Mozilla/5.0 (Android; Mobile; rv:25.0) Gecko/25.0 Firefox/25.0",
+			OS:      "Android",
+			Version: "",
+		},
+		{
+			UA:      "Mozilla/5.0 (iPhone; CPU iPhone OS 7_1_2 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) Version/7.0 Mobile/11D257 Safari/9537.53",
+			OS:      "iOS 7.1.2",
+			Version: "7.1.2",
+			Major:   7, Minor: 1, Patch: 2,
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.New(test.UA)
+
+		if got := ua.OS(); got != test.OS {
+			t.Errorf("UserAgent.OS[%s] => %s; want %s", test.UA, got, test.OS)
+		}
+
+		if got := ua.OSVersion(); got != test.Version {
+			t.Errorf("UserAgent.OSVersion[%s] => %s; want %s", test.UA, got, test.Version)
+		}
+
+		if major, minor, patch := ua.OSVersionParts(); major != test.Major || minor != test.Minor || patch != test.Patch {
+			t.Errorf("UserAgent.OSVersionParts[%s] => %d, %d, %d; want %d, %d, %d",
+				test.UA,
+				major, minor, patch,
+				test.Major, test.Minor, test.Patch,
+			)
+		}
+	}
+}
+
+func Test_UserAgent_MacOS(t *testing.T) {
+
+	tests := []struct {
+		UA, OS, Version, MarketingName string
+		Frozen                         bool
+	}{
+		{
+			UA:            "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_9_4) AppleWebKit/537.77.4 (KHTML, like Gecko) Version/7.0.5 Safari/537.77.4",
+			OS:            "macOS 10.9",
+			Version:       "10.9.4",
+			MarketingName: "Mavericks",
+		},
+		{
+			UA:            "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			OS:            "macOS 10.15",
+			Version:       "10.15.7",
+			MarketingName: "Catalina",
+			Frozen:        true,
+		},
+		{
+			UA:            "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:120.0) Gecko/20100101 Firefox/120.0",
+			OS:            "macOS 10.15",
+			Version:       "10.15",
+			MarketingName: "Catalina",
+			Frozen:        true,
+		},
+		{
+			UA:            "Mozilla/5.0 (Macintosh; Intel Mac OS X 11_2_3) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/89.0.4389.90 Safari/537.36",
+			OS:            "macOS 11",
+			Version:       "11.2.3",
+			MarketingName: "Big Sur",
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.New(test.UA)
+
+		if got := ua.OS(); got != test.OS {
+			t.Errorf("UserAgent.OS[%s] => %s; want %s", test.UA, got, test.OS)
+		}
+
+		if got := ua.OSVersion(); got != test.Version {
+			t.Errorf("UserAgent.OSVersion[%s] => %s; want %s", test.UA, got, test.Version)
+		}
+
+		if got := ua.OSMarketingName(); got != test.MarketingName {
+			t.Errorf("UserAgent.OSMarketingName[%s] => %s; want %s", test.UA, got, test.MarketingName)
+		}
+
+		if got := ua.OSFrozen(); got != test.Frozen {
+			t.Errorf("UserAgent.OSFrozen[%s] => %t; want %t", test.UA, got, test.Frozen)
+		}
+	}
+}
+
+func Test_UserAgent_Arch(t *testing.T) {
+
+	tests := []struct {
+		UA, Arch string
+		WOW64    bool
+	}{
+		{
+			UA:    "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
+			Arch:  gopheragent.ArchX64,
+			WOW64: true,
+		},
+		{
+			UA:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Arch: gopheragent.ArchX64,
+		},
+		{
+			UA:   "Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.2; ARM; Trident/6.0; Touch)",
+			Arch: gopheragent.ArchARM,
+		},
+		{
+			UA:   "Mozilla/5.0 (X11; Linux aarch64; rv:109.0) Gecko/20100101 Firefox/115.0",
+			Arch: gopheragent.ArchARM64,
+		},
+		{
+			UA:   "Mozilla/5.0 (X11; Ubuntu; Linux i686; rv:31.0) Gecko/20100101 Firefox/31.0",
+			Arch: gopheragent.ArchX86,
+		},
+		{
+			UA:   "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
+			Arch: "",
+		},
+	}
+
+	for _, test := range tests {
+		ua := gopheragent.New(test.UA)
+
+		if got := ua.Arch(); got != test.Arch {
+			t.Errorf("UserAgent.Arch[%s] => %s; want %s", test.UA, got, test.Arch)
+		}
+
+		if got := ua.WOW64(); got != test.WOW64 {
+			t.Errorf("UserAgent.WOW64[%s] => %t; want %t", test.UA, got, test.WOW64)
+		}
+	}
+}
+
+func Test_UserAgent_Concurrent(t *testing.T) {
+
+	for _, test := range testCases[:50] {
+		var (
+			ua = gopheragent.New(test.UA)
+			wg sync.WaitGroup
+		)
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if got := ua.BrowserName(); got != test.BrowserName {
+					t.Errorf("UserAgent.BrowserName[%s] => %s; want %s", test.UA, got, test.BrowserName)
+				}
+
+				if got := ua.BrowserVersion(); got != test.BrowserVersion {
+					t.Errorf("UserAgent.BrowserVersion[%s] => %s; want %s", test.UA, got, test.BrowserVersion)
+				}
+
+				if got := ua.EngineVersion(); got != test.EngineVersion {
+					t.Errorf("UserAgent.EngineVersion[%s] => %s; want %s", test.UA, got, test.EngineVersion)
+				}
+
+				if got := ua.OS(); got != test.OS {
+					t.Errorf("UserAgent.OS[%s] => %s; want %s", test.UA, got, test.OS)
+				}
+
+				if got := ua.Mobile(); got != test.Mobile {
+					t.Errorf("UserAgent.Mobile[%s] => %t; want %t", test.UA, got, test.Mobile)
+				}
+			}()
+		}
+
+		wg.Wait()
+	}
+}
+
+// Test_UserAgent_VersionAllocs guards against compiling version regexps on
+// every call, which costs hundreds of allocations
+func Test_UserAgent_VersionAllocs(t *testing.T) {
+
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable with the race detector")
+	}
+
+	for _, test := range testCases {
+		ua := gopheragent.New(test.UA)
+		ua.BrowserName()
+		ua.Engine()
+
+		if n := testing.AllocsPerRun(10, func() { ua.BrowserVersion() }); n > 2 {
+			t.Errorf("UserAgent.BrowserVersion[%s] => %v allocs; want <= 2", test.UA, n)
+		}
+
+		if n := testing.AllocsPerRun(10, func() { ua.EngineVersion() }); n > 2 {
+			t.Errorf("UserAgent.EngineVersion[%s] => %v allocs; want <= 2", test.UA, n)
+		}
+	}
+}
+
+func Benchmark_UserAgent_BrowserVersion(b *testing.B) {
+
+	uas := make([]*gopheragent.UserAgent, len(testCases))
+	for i, test := range testCases {
+		uas[i] = gopheragent.New(test.UA)
+		uas[i].BrowserName()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		uas[i%len(uas)].BrowserVersion()
+	}
+}
+
+func Benchmark_UserAgent_EngineVersion(b *testing.B) {
+
+	uas := make([]*gopheragent.UserAgent, len(testCases))
+	for i, test := range testCases {
+		uas[i] = gopheragent.New(test.UA)
+		uas[i].Engine()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		uas[i%len(uas)].EngineVersion()
+	}
+}
+
+func Benchmark_Parse(b *testing.B) {
+
+	benchmarks := []struct {
+		Name   string
+		Parser *gopheragent.Parser
+	}{
+		{"prefilter", gopheragent.NewParser()},
+		{"no_prefilter", gopheragent.NewParser(gopheragent.WithoutPrefilter())},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.Name, func(b *testing.B) {
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				bm.Parser.Parse(testCases[i%len(testCases)].UA)
+			}
+		})
 	}
 }
 
@@ -98,55 +387,60 @@ func init() {
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_11_1) AppleWebKit/537.36 (KHTML, like Gecko) Remind(BETA)Dev/0.3.1 Chrome/45.0.2454.85 Electron/0.34.2 Safari/537.36",
 			BrowserName:    "desktop",
 			BrowserVersion: "0.34.2",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.11",
+			Engine:         "blink",
+			EngineVersion:  "45",
+			OS:             "macOS 10.11",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.143",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "36",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.143",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "36",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (X11; CrOS x86_64 5978.80.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.119 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.119",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "37",
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.68 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.68",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "37",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -155,20 +449,22 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; ZTE_N9511 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -180,39 +476,43 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; DROID RAZR HD Build/KDA20.62-10.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_1_2 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) CriOS/36.0.1985.49 Mobile/11D257 Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "36.0.1985.49",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPad OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; U; Android 4.4.2; en-us; LG-D950/D95020b Build/KOT49I.D95020b) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.1599.103 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.1599.103",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -221,9 +521,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -235,17 +536,19 @@ func init() {
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.102 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.102",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "37",
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -254,9 +557,10 @@ func init() {
 			BrowserVersion: "7.0.1",
 			Engine:         "webkit",
 			EngineVersion:  "537.73.11",
-			OS:             "OS X 10.9",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -268,17 +572,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; en-us; SAMSUNG SM-S765C Build/JLS36C) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.5 Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -287,9 +593,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -301,6 +608,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -309,42 +617,46 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SPH-M840 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; en-us; SAMSUNG SM-T230NU Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.5 Chrome/28.0.1500.94 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/29.0.1547.76 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "29.0.1547.76",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "29",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -356,6 +668,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -364,20 +677,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; SCH-R530C Build/IMM76D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -386,20 +701,22 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "msie",
 			EngineVersion:  "7.0",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; GT-I9500 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 MicroMessenger/5.2.1.400",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -411,17 +728,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (X11; CrOS x86_64 5978.81.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.119 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.119",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "37",
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -430,20 +749,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SM-N900P Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -455,50 +776,55 @@ func init() {
 			OS:             "Unknown",
 			Platform:       "blackberry",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; N9520 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (X11; CrOS armv7l 4731.104.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.69 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.69",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "31",
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LGLS990 Build/KVT49L.LS990ZV4) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LG-D801 Build/KOT49I.D80120e) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -507,9 +833,10 @@ func init() {
 			BrowserVersion: "18.0.1025.166",
 			Engine:         "webkit",
 			EngineVersion:  "535.19",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -518,53 +845,58 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; U; Android 4.4.2; en-us; SAMSUNG-SGH-I747 Build/KOT49H) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 UCBrowser/9.9.2.467 U3/0.8.0 Mobile Safari/533.1",
-			BrowserName:    "safari",
-			BrowserVersion: "4.0",
+			BrowserName:    "ucbrowser",
+			BrowserVersion: "9.9.2.467",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; LG-MS770 Build/IMM76I) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SPH-L720 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-R970X Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -573,42 +905,46 @@ func init() {
 			BrowserVersion: "25.0",
 			Engine:         "gecko",
 			EngineVersion:  "25.0",
-			OS:             "Unknown",
+			OS:             "Android",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_0_2 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/37.0.2062.52 Mobile/11A501 Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "37.0.2062.52",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; en-us; SAMSUNG SM-N900 Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.5 Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SGH-T889 Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -617,20 +953,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; HTC One Build/KTU84P.H1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -642,6 +980,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -653,28 +992,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 5.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.137 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "34.0.1847.137",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "34",
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SM-N900T Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -683,9 +1025,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -697,6 +1040,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -708,28 +1052,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; VS876 Build/KOT49I.VS87611B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_0_4 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/36.0.1985.57 Mobile/11B554a Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "36.0.1985.57",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0.4",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -741,6 +1088,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -749,20 +1097,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; WOW64; Trident/4.0; chromeframe/27.0.1453.94; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; .NET4.0C; .NET4.0E)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
+			Engine:         "webkit",
 			EngineVersion:  "",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -771,9 +1121,10 @@ func init() {
 			BrowserVersion: "29.0",
 			Engine:         "gecko",
 			EngineVersion:  "20100101",
-			OS:             "OS X 10.8",
+			OS:             "macOS 10.8",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -785,39 +1136,43 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; HTC One mini Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.3; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Maxthon/4.4.1.3000 Chrome/30.0.1599.101 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.1599.101",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; ASUS PadFone X Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -826,20 +1181,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.2; ALCATEL ONE TOUCH Fierce Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/32.0.1700.99 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "32.0.1700.99",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "32",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -851,17 +1208,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; XT1028 Build/KXB20.9-1.10-1.20) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -873,6 +1232,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -881,20 +1241,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; Z740 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.170 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.170",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -906,6 +1268,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -914,9 +1277,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -928,6 +1292,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -939,6 +1304,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -950,6 +1316,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -958,9 +1325,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -972,28 +1340,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-I535 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050066",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.1; NX008HD8G Build/JRO03C) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.136 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.136",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -1005,6 +1376,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1013,42 +1385,46 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; C6906 Build/14.4.A.0.108) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; VS870 4G Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; C6750 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1060,6 +1436,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1068,9 +1445,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1079,9 +1457,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.16",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1093,6 +1472,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1101,9 +1481,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1115,6 +1496,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1123,20 +1505,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SGH-I337M Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1145,9 +1529,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1159,17 +1544,19 @@ func init() {
 			OS:             "Linux",
 			Platform:       "linux",
 			Mobile:         false,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; XT1031 Build/KXB20.9-1.10-1.9) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1181,6 +1568,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1189,9 +1577,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1200,9 +1589,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1214,17 +1604,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; DROID RAZR Build/9.8.2O-72_VZW-16-5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36 OPR/22.0.1485.78487",
-			BrowserName:    "chrome",
-			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			BrowserName:    "opera",
+			BrowserVersion: "22.0.1485.78487",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1236,6 +1628,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1247,6 +1640,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1255,20 +1649,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.16",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.3; HTCEVOV4G Build/IML74K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1277,9 +1673,10 @@ func init() {
 			BrowserVersion: "18.0.1025.166",
 			Engine:         "webkit",
 			EngineVersion:  "535.19",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1291,28 +1688,31 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; WOW64; Trident/4.0; chromeframe/21.0.1180.75; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; .NET CLR 1.1.4322; .NET4.0C; .NET4.0E; InfoPath.3)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
+			Engine:         "webkit",
 			EngineVersion:  "",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; SM-G900V Build/KTU84P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1324,6 +1724,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1332,9 +1733,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1343,20 +1745,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 7_1 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/33.0.1750.21 Mobile/11D167 Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "33.0.1750.21",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1368,6 +1772,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1376,9 +1781,10 @@ func init() {
 			BrowserVersion: "7.1",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1387,20 +1793,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; LG-AS730 Build/IMM76L) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1412,17 +1820,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; Venue 7 3740 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -1434,17 +1844,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; Z750C Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1453,9 +1865,10 @@ func init() {
 			BrowserVersion: "29.0",
 			Engine:         "gecko",
 			EngineVersion:  "20100101",
-			OS:             "Windows 7",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1464,20 +1877,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; Trident/4.0; chromeframe/32.0.1700.107; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; .NET CLR 1.1.4322; InfoPath.3)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
-			EngineVersion:  "",
+			Engine:         "blink",
+			EngineVersion:  "32",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1489,6 +1904,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1497,9 +1913,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1508,31 +1925,34 @@ func init() {
 			BrowserVersion: "4.1",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SAMSUNG-SGH-I497 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; DROID4 Build/9.8.2O-72_VZW-18-8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1541,9 +1961,10 @@ func init() {
 			BrowserVersion: "26.0.1410.58",
 			Engine:         "webkit",
 			EngineVersion:  "537.31",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1552,9 +1973,10 @@ func init() {
 			BrowserVersion: "5.1.1",
 			Engine:         "webkit",
 			EngineVersion:  "534.57.7",
-			OS:             "OS X 10.7",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1566,6 +1988,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1577,17 +2000,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; HTC6525LVW Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1596,42 +2021,46 @@ func init() {
 			BrowserVersion: "10.0",
 			Engine:         "gecko",
 			EngineVersion:  "20120104",
-			OS:             "Linux",
+			OS:             "Android",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SPH-L710 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; XT907 Build/KDA20.62-15) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 5.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/38.0.2125.24 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "38.0.2125.24",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "38",
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1640,9 +2069,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1654,6 +2084,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1665,6 +2096,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1676,6 +2108,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1687,6 +2120,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1698,6 +2132,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1709,17 +2144,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; EVO Build/JSS15Q) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1731,6 +2168,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1742,6 +2180,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1753,6 +2192,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1761,9 +2201,10 @@ func init() {
 			BrowserVersion: "5.1.9",
 			Engine:         "webkit",
 			EngineVersion:  "534.59.10",
-			OS:             "OS X 10.6",
+			OS:             "macOS 10.6",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1775,6 +2216,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1786,17 +2228,19 @@ func init() {
 			OS:             "Linux",
 			Platform:       "linux",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; SGH-T989 Build/IMM76D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1808,17 +2252,19 @@ func init() {
 			OS:             "Unknown",
 			Platform:       "blackberry",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; Z730 Build/JLS36C) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1827,42 +2273,46 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; Trident/4.0; chromeframe/32.0.1700.107; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; InfoPath.3; .NET4.0C; MS-RTC LM 8; .NET4.0E)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
-			EngineVersion:  "",
+			Engine:         "blink",
+			EngineVersion:  "32",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; HTC One_M8 Build/KOT49H.H16) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SAMSUNG-SM-N900A Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1874,6 +2324,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1885,6 +2336,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1896,6 +2348,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1907,17 +2360,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-I605 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 MxBrowser/4.3.1.2000",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1926,9 +2381,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1937,9 +2393,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -1951,6 +2408,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1959,9 +2417,10 @@ func init() {
 			BrowserVersion: "27.0.1453.94",
 			Engine:         "webkit",
 			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1970,9 +2429,10 @@ func init() {
 			BrowserVersion: "3.6.14",
 			Engine:         "gecko",
 			EngineVersion:  "20110218",
-			OS:             "OS X 10.9",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -1984,17 +2444,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; en-us; SAMSUNG SM-N900W8 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.5 Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2006,6 +2468,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2017,6 +2480,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2025,9 +2489,10 @@ func init() {
 			BrowserVersion: "20.0.1132.57",
 			Engine:         "webkit",
 			EngineVersion:  "536.11",
-			OS:             "OS X 10.7",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2039,6 +2504,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2050,6 +2516,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2058,9 +2525,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2072,6 +2540,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2083,6 +2552,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2094,6 +2564,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2105,6 +2576,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2116,17 +2588,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.132 Safari/537.36 OPR/21.0.1432.67",
-			BrowserName:    "chrome",
-			BrowserVersion: "34.0.1847.132",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			BrowserName:    "opera",
+			BrowserVersion: "21.0.1432.67",
+			Engine:         "blink",
+			EngineVersion:  "34",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2138,6 +2612,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2149,17 +2624,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPod; CPU iPhone OS 6_1_6 like Mac OS X) AppleWebKit/536.26 (KHTML, like Gecko) CriOS/30.0.1599.16 Mobile/10B500 Safari/8536.25",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "30.0.1599.16",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "Unknown",
+			OS:             "iOS 6.1.6",
 			Platform:       "ipod",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2171,6 +2648,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2182,17 +2660,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; Trident/7.0; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; .NET4.0C; InfoPath.2; Tablet PC 2.0; .NET4.0E; Stratford ISD; MALC)",
 			BrowserName:    "ie",
 			BrowserVersion: "7.0",
-			Engine:         "msie",
+			Engine:         "trident",
 			EngineVersion:  "7.0",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2204,6 +2684,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2215,6 +2696,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2223,20 +2705,22 @@ func init() {
 			BrowserVersion: "19.0.1084.52",
 			Engine:         "webkit",
 			EngineVersion:  "536.5",
-			OS:             "OS X 10.9",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Maxthon/4.4.1.2001 Chrome/30.0.1599.101 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.1599.101",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "30",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2245,20 +2729,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SCH-R530U Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2270,17 +2756,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SGH-I897 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2289,9 +2777,10 @@ func init() {
 			BrowserVersion: "10.0",
 			Engine:         "msie",
 			EngineVersion:  "10.0",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2303,6 +2792,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2314,6 +2804,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2325,17 +2816,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_0 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/31.0.1650.18 Mobile/11A465 Safari/8536.25",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "31.0.1650.18",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -2347,6 +2840,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2358,6 +2852,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2369,6 +2864,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2380,6 +2876,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2391,6 +2888,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2399,9 +2897,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2413,6 +2912,7 @@ func init() {
 			OS:             "Unknown",
 			Platform:       "blackberry",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2424,17 +2924,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.2; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.125 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.125",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2443,9 +2945,10 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -2454,42 +2957,46 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SPH-L520 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (X11; CrOS armv7l 5500.130.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.134 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "34.0.1847.134",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "34",
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (X11; CrOS x86_64 5841.74.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.126 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.126",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "36",
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -2498,20 +3005,22 @@ func init() {
 			BrowserVersion: "6.1.4",
 			Engine:         "webkit",
 			EngineVersion:  "537.76.4",
-			OS:             "OS X 10.7",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; XT907 Build/KDA20.62-10.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2523,6 +3032,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2531,20 +3041,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.5",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SGH-T989 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.122 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.122",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2556,6 +3068,7 @@ func init() {
 			OS:             "Unknown",
 			Platform:       "blackberry",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2567,6 +3080,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2575,9 +3089,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2589,28 +3104,31 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; HTCONE Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SAMSUNG-SGH-I337 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.166 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.166",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2619,20 +3137,22 @@ func init() {
 			BrowserVersion: "8.0",
 			Engine:         "webkit",
 			EngineVersion:  "600.1.3",
-			OS:             "iPhone OS 8.0",
+			OS:             "iOS 8.0",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; XT907 Build/KDA20.62-10) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.122 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.122",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2641,53 +3161,58 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.3; SAMSUNG-SGH-I747 Build/KTU84M) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/33.0.0.0 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 5.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.154 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.154",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "33",
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 7_1 like Mac OS X) AppleWebKit/537.51.2 (KHTML, like Gecko) CriOS/36.0.1985.57 Mobile/11D167 Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "36.0.1985.57",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; Z796C Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2699,17 +3224,19 @@ func init() {
 			OS:             "Linux",
 			Platform:       "linux",
 			Mobile:         false,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-I545 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36 OPR/22.0.1485.81203",
-			BrowserName:    "chrome",
-			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			BrowserName:    "opera",
+			BrowserVersion: "22.0.1485.81203",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2718,20 +3245,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.2; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.146 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.146",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2743,6 +3272,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2751,9 +3281,10 @@ func init() {
 			BrowserVersion: "28.0",
 			Engine:         "gecko",
 			EngineVersion:  "20100101",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2765,6 +3296,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2773,9 +3305,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.13",
-			OS:             "Linux",
+			OS:             "Android 3.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -2784,9 +3317,10 @@ func init() {
 			BrowserVersion: "5.1.3",
 			Engine:         "webkit",
 			EngineVersion:  "534.53.11",
-			OS:             "OS X 10.7",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2798,28 +3332,31 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_7_5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.63 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.63",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.7",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SGH-I747M Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2831,17 +3368,19 @@ func init() {
 			OS:             "Windows Phone",
 			Platform:       "windows_phone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.3) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.120 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.120",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2853,6 +3392,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2861,9 +3401,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2872,20 +3413,22 @@ func init() {
 			BrowserVersion: "27.0.1453.116",
 			Engine:         "webkit",
 			EngineVersion:  "537.36",
-			OS:             "OS X 10.7",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SCH-I535 Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2894,20 +3437,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-R970C Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050050",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2919,17 +3464,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-R970C Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.128 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.128",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -2941,6 +3488,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2952,6 +3500,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2963,6 +3512,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2974,6 +3524,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2985,6 +3536,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -2993,53 +3545,58 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SM-T217S Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.3; XT1034 Build/KXB21.14-L1.32) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/33.0.0.0 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; SCH-S738C Build/IMM76D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; XT1042 Build/KXB21.14-L1.41) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3051,6 +3608,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3062,17 +3620,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; Event Build/IML77) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3081,9 +3641,10 @@ func init() {
 			BrowserVersion: "5.11.2",
 			Engine:         "webkit",
 			EngineVersion:  "533.21.1",
-			OS:             "OS X 10.5",
+			OS:             "macOS 10.5",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3095,6 +3656,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3103,20 +3665,22 @@ func init() {
 			BrowserVersion: "5.0.5",
 			Engine:         "webkit",
 			EngineVersion:  "534.58.2",
-			OS:             "OS X 10.6",
+			OS:             "macOS 10.6",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.1; M766 Build/JOP40D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.2.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3128,6 +3692,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3136,53 +3701,58 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; Nexus 5 Build/KTU84P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.114 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "34.0.1847.114",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "34",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; XT1030 Build/SU4.21) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; ALCATEL ONE TOUCH 5020N Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; XT897 Build/9.8.2Q-122_XT897_FFW-5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3191,9 +3761,10 @@ func init() {
 			BrowserVersion: "18.0.1025.166",
 			Engine:         "webkit",
 			EngineVersion:  "535.19",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3202,20 +3773,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SM-G900R7 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3227,6 +3800,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3235,9 +3809,10 @@ func init() {
 			BrowserVersion: "4.2",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3249,6 +3824,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3260,6 +3836,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3271,6 +3848,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3279,20 +3857,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 5.1; Trident/4.0; chromeframe/32.0.1700.107; .NET CLR 2.0.50727; .NET CLR 3.0.4506.2152; .NET CLR 3.5.30729; .NET CLR 1.1.4322; InfoPath.1; BOIE8;ENUS)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
-			EngineVersion:  "",
+			Engine:         "blink",
+			EngineVersion:  "32",
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3304,6 +3884,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3312,20 +3893,22 @@ func init() {
 			BrowserVersion: "10.0",
 			Engine:         "msie",
 			EngineVersion:  "10.0",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Maxthon/3.0 Chrome/26.0.1410.43 Safari/535.12",
 			BrowserName:    "chrome",
 			BrowserVersion: "26.0.1410.43",
-			Engine:         "chrome",
-			EngineVersion:  "26.0.1410.43",
+			Engine:         "webkit",
+			EngineVersion:  "",
 			OS:             "Unknown",
 			Platform:       "unknown",
 			Mobile:         false,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -3334,9 +3917,10 @@ func init() {
 			BrowserVersion: "9.80",
 			Engine:         "presto",
 			EngineVersion:  "2.8.119",
-			OS:             "Unknown",
+			OS:             "Android",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3348,6 +3932,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3356,9 +3941,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3370,17 +3956,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; es-us; SAMSUNG SPH-L600 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.5 Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3392,39 +3980,43 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SPH-M840 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; WOW64; Trident/7.0; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; .NET4.0C; .NET4.0E; BRI/2; InfoPath.3)",
 			BrowserName:    "ie",
 			BrowserVersion: "7.0",
-			Engine:         "msie",
+			Engine:         "trident",
 			EngineVersion:  "7.0",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SCH-L710 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050050",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3436,6 +4028,7 @@ func init() {
 			OS:             "Windows Phone",
 			Platform:       "windows_phone",
 			Mobile:         true,
+			DeviceType:     "console",
 		},
 
 		UserAgentTestCase{
@@ -3447,6 +4040,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3458,6 +4052,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3466,20 +4061,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.3; GT-I9100 Build/IML74K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3488,9 +4085,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3502,17 +4100,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; Galaxy Nexus Build/JWR66Y) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3521,20 +4121,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.2; VK810 4G Build/JDQ39B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -3543,9 +4145,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3554,9 +4157,10 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "msie",
 			EngineVersion:  "7.0",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3568,17 +4172,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_0_2 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/35.0.1916.38 Mobile/11A501 Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "35.0.1916.38",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -3590,6 +4196,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3601,17 +4208,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_9_4) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/38.0.2125.8 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "38.0.2125.8",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.9",
+			Engine:         "blink",
+			EngineVersion:  "38",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3623,28 +4232,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_9_1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/32.0.1700.102 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "32.0.1700.102",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.9",
+			Engine:         "blink",
+			EngineVersion:  "32",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; HTC6600LVW Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.138 Safari/537.36 OPR/22.0.1485.81203",
-			BrowserName:    "chrome",
-			BrowserVersion: "35.0.1916.138",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			BrowserName:    "opera",
+			BrowserVersion: "22.0.1485.81203",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -3656,6 +4268,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3667,6 +4280,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3678,28 +4292,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; XT1080 Build/SU4.21) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/33.0.0.0 Mobile Safari/537.36 MxBrowser/4.3.1.2000",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; U; Android 4.4.4; en-us; XT1030 Build/SU4.21) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 UCBrowser/9.9.2.467 U3/0.8.0 Mobile Safari/533.1",
-			BrowserName:    "safari",
-			BrowserVersion: "4.0",
+			BrowserName:    "ucbrowser",
+			BrowserVersion: "9.9.2.467",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3711,6 +4328,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3722,17 +4340,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_9_2) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.48 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.48",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.9",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3741,9 +4361,10 @@ func init() {
 			BrowserVersion: "18.0.1025.308",
 			Engine:         "webkit",
 			EngineVersion:  "535.19",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3755,28 +4376,31 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 7_0_3 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/32.0.1700.20 Mobile/11B511 Safari/9537.53",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "32.0.1700.20",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.3",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; C6806_GPe Build/KTU84P.S1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3788,6 +4412,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3799,6 +4424,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3810,6 +4436,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3818,9 +4445,10 @@ func init() {
 			BrowserVersion: "5.0.3",
 			Engine:         "webkit",
 			EngineVersion:  "533.19.4",
-			OS:             "OS X 10.5",
+			OS:             "macOS 10.5",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3832,6 +4460,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3843,6 +4472,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3851,9 +4481,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3865,6 +4496,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3876,6 +4508,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3887,6 +4520,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3898,6 +4532,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3909,6 +4544,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3920,6 +4556,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3931,28 +4568,31 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; LT30p Build/9.2.A.1.199) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; HTCONE Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.136 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.136",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -3964,17 +4604,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_8_4) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.116 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "34.0.1847.116",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.8",
+			Engine:         "blink",
+			EngineVersion:  "34",
+			OS:             "macOS 10.8",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -3983,9 +4625,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -3994,20 +4637,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; B15 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4019,6 +4664,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4030,6 +4676,7 @@ func init() {
 			OS:             "Linux",
 			Platform:       "linux",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4041,6 +4688,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4052,6 +4700,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4060,9 +4709,10 @@ func init() {
 			BrowserVersion: "23.0.1271.95",
 			Engine:         "webkit",
 			EngineVersion:  "537.11",
-			OS:             "OS X 10.8",
+			OS:             "macOS 10.8",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4071,20 +4721,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.2; GT-P5200 Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -4093,9 +4745,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4107,28 +4760,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SM-G900R6 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SPH-D710VMUB Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4137,9 +4793,10 @@ func init() {
 			BrowserVersion: "5.0.2",
 			Engine:         "webkit",
 			EngineVersion:  "533.17.9",
-			OS:             "iPad OS 4.3",
+			OS:             "iOS 4.3.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -4148,20 +4805,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.1; C1504 Build/11.3.A.2.33) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4170,9 +4829,10 @@ func init() {
 			BrowserVersion: "18.0.1025.166",
 			Engine:         "webkit",
 			EngineVersion:  "535.19",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4184,6 +4844,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4195,6 +4856,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4206,17 +4868,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.2; KINGWILL F508 Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.128 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.128",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4228,6 +4892,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4239,6 +4904,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4247,9 +4913,10 @@ func init() {
 			BrowserVersion: "6.0",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "iPhone OS 6.0",
+			OS:             "iOS 6.0",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4261,6 +4928,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4272,28 +4940,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; LGMS659 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.136 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.136",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; Nexus 5 Build/KTU84P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.76 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.76",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4305,6 +4976,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4313,20 +4985,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; en-us; SAMSUNG-SGH-I337 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.6 Chrome/28.0.1500.94 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "28.0.1500.94",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "28",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4338,17 +5012,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LG-E980 Build/KOT49I.E98020h) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050068",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4357,20 +5033,22 @@ func init() {
 			BrowserVersion: "26.0.1410.58",
 			Engine:         "webkit",
 			EngineVersion:  "537.31",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; SCH-S735C Build/IMM76D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4382,6 +5060,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4390,20 +5069,22 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.2; NX785QC8G Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -4415,6 +5096,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4423,9 +5105,10 @@ func init() {
 			BrowserVersion: "18.0.1025.166",
 			Engine:         "webkit",
 			EngineVersion:  "535.19",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4437,17 +5120,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; XT1058 Build/KXA20.16-1.31.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4459,50 +5144,55 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; SPH-D710 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.2; HTC6500LVW Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; Z930L Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; XT897 Build/9.8.2Q-122_XT897_FFW-5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.128 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.128",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4511,20 +5201,22 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.4",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; LG-E980 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4536,6 +5228,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4547,6 +5240,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4558,61 +5252,67 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LG-LS980 Build/KOT49I.LS980ZVC) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_6_3) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.143",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.6",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "macOS 10.6",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SAMSUNG-SM-N900A Build/JSS15J) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.128 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.128",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.2; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Dragon/33.1.0.0 Chrome/33.0.1750.152 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.152",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.1; SAMSUNG-SGH-I317 Build/JRO03C) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4624,6 +5324,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4632,9 +5333,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4646,6 +5348,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4654,9 +5357,10 @@ func init() {
 			BrowserVersion: "18.0",
 			Engine:         "gecko",
 			EngineVersion:  "20100101",
-			OS:             "OS X 10.8",
+			OS:             "macOS 10.8",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4668,6 +5372,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4679,39 +5384,43 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; Z750C Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.59 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "31.0.1650.59",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "31",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; WOW64; Trident/4.0; chromeframe/29.0.1547.67; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; InfoPath.3; .NET4.0C; .NET4.0E)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
-			EngineVersion:  "",
+			Engine:         "blink",
+			EngineVersion:  "29",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LG-D950 Build/KOT49I.D95020b) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050050",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4723,6 +5432,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4731,9 +5441,10 @@ func init() {
 			BrowserVersion: "26.0.1410.58",
 			Engine:         "webkit",
 			EngineVersion:  "537.31",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4745,17 +5456,19 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LGMS323 Build/KOT49I.MS32310b) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050050",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4767,17 +5480,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_0 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) CriOS/30.0.1599.12 Mobile/11A465 Safari/8536.25",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "30.0.1599.12",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -4789,6 +5504,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4800,6 +5516,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4811,6 +5528,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4822,28 +5540,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (MSIE 9.0; Windows NT 6.1; WOW64; Trident/7.0; NP06; rv:11.0) like Gecko",
 			BrowserName:    "ie",
-			BrowserVersion: "9.0",
-			Engine:         "gecko",
-			EngineVersion:  "",
+			BrowserVersion: "11.0",
+			Engine:         "trident",
+			EngineVersion:  "7.0",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 5.1; Trident/4.0; chromeframe/32.0.1700.107; InfoPath.2; .NET CLR 1.1.4322; .NET CLR 2.0.50727; .NET CLR 3.0.4506.2152; .NET CLR 3.5.30729; .NET4.0C; yie8)",
 			BrowserName:    "chrome",
 			BrowserVersion: "",
-			Engine:         "chrome",
-			EngineVersion:  "",
+			Engine:         "blink",
+			EngineVersion:  "32",
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4855,6 +5576,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4866,17 +5588,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 5_1_1 like Mac OS X) AppleWebKit/534.46 (KHTML, like Gecko) CriOS/29.0.1547.11 Mobile/9B208 Safari/7534.48.3",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "29.0.1547.11",
 			Engine:         "webkit",
 			EngineVersion:  "534.46",
-			OS:             "iPhone OS 5.1",
+			OS:             "iOS 5.1.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4888,6 +5612,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4896,31 +5621,34 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.16",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.1.2; LG-D500 Build/JZO54K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/33.0.1750.166 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "33.0.1750.166",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "33",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SAMSUNG-SM-G900AZ Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.117 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.117",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4932,6 +5660,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4943,17 +5672,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.3; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/38.0.2125.58 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "38.0.2125.58",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "38",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -4965,28 +5696,31 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_6_8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/38.0.2125.66 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "38.0.2125.66",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.6",
+			Engine:         "blink",
+			EngineVersion:  "38",
+			OS:             "macOS 10.6",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 6_1_3 like Mac OS X) AppleWebKit/536.26 (KHTML, like Gecko) CriOS/26.0.1410.53 Mobile/10B329 Safari/8536.25",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "26.0.1410.53",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "iPhone OS 6.1",
+			OS:             "iOS 6.1.3",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -4995,9 +5729,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPad OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -5009,17 +5744,19 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; N9510 Build/KVT49L) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5031,39 +5768,43 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; HTC6525LVW Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 MicroMessenger/5.2.1.381",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; PantechP9090 Build/IMM76I) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.114 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "34.0.1847.114",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "34",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/38.0.2125.0 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "38.0.2125.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "38",
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5072,9 +5813,10 @@ func init() {
 			BrowserVersion: "22.0.1229.94",
 			Engine:         "webkit",
 			EngineVersion:  "537.4",
-			OS:             "OS X 10.6",
+			OS:             "macOS 10.6",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5086,6 +5828,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5094,9 +5837,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5108,17 +5852,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; U; Android 4.2.2; en-au; KFAPWI Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Silk/3.23 like Chrome/34.0.1847.137 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "34.0.1847.137",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "34",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -5127,31 +5873,34 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.3.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; MT2L03 Build/HuaweiMT2L03) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; SAMSUNG-SGH-I747 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36 ACHEETAHI/2100050034",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5160,9 +5909,10 @@ func init() {
 			BrowserVersion: "5.0.2",
 			Engine:         "webkit",
 			EngineVersion:  "533.17.9",
-			OS:             "iPad OS 4.3",
+			OS:             "iOS 4.3.5",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -5171,9 +5921,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5185,6 +5936,7 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5196,6 +5948,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5207,6 +5960,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5215,9 +5969,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5229,6 +5984,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5237,20 +5993,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_7_5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/38.0.2125.58 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "38.0.2125.58",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.7",
+			Engine:         "blink",
+			EngineVersion:  "38",
+			OS:             "macOS 10.7",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5262,6 +6020,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5270,31 +6029,34 @@ func init() {
 			BrowserVersion: "10.0",
 			Engine:         "msie",
 			EngineVersion:  "10.0",
-			OS:             "Windows 7",
+			OS:             "Windows 8",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.3; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/39.0.2164.0 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "39.0.2164.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "39",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_8_1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/37.0.2062.122 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "37.0.2062.122",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "OS X 10.8",
+			Engine:         "blink",
+			EngineVersion:  "37",
+			OS:             "macOS 10.8",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5306,28 +6068,31 @@ func init() {
 			OS:             "Linux",
 			Platform:       "linux",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SM-G900T Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050074",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.2.1; BLU Life One Build/JOP40D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.2.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5339,6 +6104,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5350,17 +6116,19 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.3; GT-I9300I Build/JLS36C) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/30.0.1599.82 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.1599.82",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5369,9 +6137,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5383,6 +6152,7 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5391,9 +6161,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5402,9 +6173,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5416,39 +6188,43 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPhone; CPU iPhone OS 6_1_4 like Mac OS X) AppleWebKit/536.26 (KHTML, like Gecko) CriOS/31.0.1650.18 Mobile/10B350 Safari/8536.25",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "31.0.1650.18",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "iPhone OS 6.1",
+			OS:             "iOS 6.1.4",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; SPH-L720T Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/30.0.0.0 Mobile Safari/537.36 ACHEETAHI/2100050068",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.0.0",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.3; PG86100 Build/IML74K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5457,9 +6233,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 2.3.5",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5471,6 +6248,7 @@ func init() {
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5482,83 +6260,91 @@ func init() {
 			OS:             "Windows Vista",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.4; XT1056 Build/KXA21.12-L1.28) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.131 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.131",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.0.4; LG-MS770 Build/IMM76I) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/35.0.1916.141 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "35.0.1916.141",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "35",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; WOW64; Trident/7.0; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; MAM3; BRI/1; .NET4.0C; .NET4.0E)",
 			BrowserName:    "ie",
 			BrowserVersion: "7.0",
-			Engine:         "msie",
+			Engine:         "trident",
 			EngineVersion:  "7.0",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; LG-D321 Build/KOT49I.D32110c) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/30.0.1599.103 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "30.0.1599.103",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "30",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; GT-I9192 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (iPad; CPU OS 7_1_1 like Mac OS X; en-us) AppleWebKit/536.26 (KHTML, like Gecko) CriOS/23.0.1271.100 Mobile/11D201 Safari/8536.25",
-			BrowserName:    "safari",
-			BrowserVersion: "",
+			BrowserName:    "chrome",
+			BrowserVersion: "23.0.1271.100",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "iPad OS 7.1",
+			OS:             "iOS 7.1.1",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; U; Android 4.1.2; en-us; GT-N7000 Build/JZO54K) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 UCBrowser/9.8.9.457 U3/0.8.0 Mobile Safari/533.1",
-			BrowserName:    "safari",
-			BrowserVersion: "4.0",
+			BrowserName:    "ucbrowser",
+			BrowserVersion: "9.8.9.457",
 			Engine:         "webkit",
 			EngineVersion:  "533.1",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5570,6 +6356,7 @@ func init() {
 			OS:             "Unknown",
 			Platform:       "blackberry",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5578,9 +6365,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5592,17 +6380,19 @@ func init() {
 			OS:             "Windows XP",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Linux; Android 4.4.2; AS876 Build/KOT49I.AS87610a) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.135 Mobile Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.135",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Linux",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5611,20 +6401,22 @@ func init() {
 			BrowserVersion: "6.1.3",
 			Engine:         "webkit",
 			EngineVersion:  "537.75.14",
-			OS:             "OS X 10.8",
+			OS:             "macOS 10.8",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.143 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.143",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
+			Engine:         "blink",
+			EngineVersion:  "36",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5633,9 +6425,10 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5644,20 +6437,22 @@ func init() {
 			BrowserVersion: "4.0",
 			Engine:         "webkit",
 			EngineVersion:  "534.30",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.3; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/36.0.1985.125 Safari/537.36",
 			BrowserName:    "chrome",
 			BrowserVersion: "36.0.1985.125",
-			Engine:         "webkit",
-			EngineVersion:  "537.36",
-			OS:             "Windows 7",
+			Engine:         "blink",
+			EngineVersion:  "36",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5666,9 +6461,10 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5677,9 +6473,10 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5688,9 +6485,10 @@ func init() {
 			BrowserVersion: "7.0.1",
 			Engine:         "webkit",
 			EngineVersion:  "537.73.11",
-			OS:             "OS X 10.9",
+			OS:             "macOS 10.9",
 			Platform:       "macintosh",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5699,9 +6497,10 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5710,9 +6509,10 @@ func init() {
 			BrowserVersion: "7.0",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPad OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -5721,9 +6521,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5732,9 +6533,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5743,9 +6545,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5754,9 +6557,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5765,20 +6569,22 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "536.26",
-			OS:             "iPhone OS 6.1",
+			OS:             "iOS 6.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; NP08; MAAU; rv:11.0) like Gecko",
-			BrowserName:    "unknown",
-			BrowserVersion: "",
-			Engine:         "gecko",
-			EngineVersion:  "",
+			BrowserName:    "ie",
+			BrowserVersion: "11.0",
+			Engine:         "trident",
+			EngineVersion:  "7.0",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5787,9 +6593,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5798,9 +6605,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5809,20 +6617,22 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (AOL 9.7; AOLBuild 4343.19; Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko",
-			BrowserName:    "unknown",
-			BrowserVersion: "",
-			Engine:         "gecko",
-			EngineVersion:  "",
+			BrowserName:    "ie",
+			BrowserVersion: "11.0",
+			Engine:         "trident",
+			EngineVersion:  "7.0",
 			OS:             "Windows 7",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -5831,9 +6641,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.3",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5842,9 +6653,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.2",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5853,9 +6665,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "iPad OS 7.0",
+			OS:             "iOS 7.0.4",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -5864,9 +6677,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPhone OS 7.1",
+			OS:             "iOS 7.1.1",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5875,9 +6689,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5886,9 +6701,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5897,9 +6713,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5908,9 +6725,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.0.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5919,9 +6737,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5930,9 +6749,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "iPad OS 6.1",
+			OS:             "iOS 6.1.3",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -5941,9 +6761,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5952,9 +6773,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5963,9 +6785,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5974,9 +6797,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.4",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -5985,9 +6809,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -5996,9 +6821,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6007,9 +6833,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.0.4",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6018,9 +6845,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPad OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -6029,9 +6857,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6040,9 +6869,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6051,9 +6881,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.3",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6062,9 +6893,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6073,9 +6905,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6084,9 +6917,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6095,9 +6929,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 2.3.6",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6106,9 +6941,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6117,9 +6953,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6128,9 +6965,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6139,9 +6977,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6150,9 +6989,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.2",
-			OS:             "iPad OS 7.1",
+			OS:             "iOS 7.1.2",
 			Platform:       "ipad",
 			Mobile:         true,
+			DeviceType:     "tablet",
 		},
 
 		UserAgentTestCase{
@@ -6161,20 +7001,22 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
 			UA:             "Mozilla/5.0 (Windows NT 6.3; WOW64; Trident/7.0; VER#6D#80836769506745484871484871; MATBJS; rv:11.0) like Gecko",
-			BrowserName:    "unknown",
-			BrowserVersion: "",
-			Engine:         "gecko",
-			EngineVersion:  "",
-			OS:             "Windows 7",
+			BrowserName:    "ie",
+			BrowserVersion: "11.0",
+			Engine:         "trident",
+			EngineVersion:  "7.0",
+			OS:             "Windows 8.1",
 			Platform:       "windows",
 			Mobile:         false,
+			DeviceType:     "desktop",
 		},
 
 		UserAgentTestCase{
@@ -6183,9 +7025,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.4.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6194,9 +7037,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6205,9 +7049,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.1.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6216,9 +7061,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6227,9 +7073,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "webkit",
 			EngineVersion:  "537.51.1",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.6",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
 		},
 
 		UserAgentTestCase{
@@ -6238,9 +7085,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.1",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6249,9 +7097,10 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "Linux",
+			OS:             "Android 4.2.2",
 			Platform:       "android",
 			Mobile:         true,
+			DeviceType:     "unknown",
 		},
 
 		UserAgentTestCase{
@@ -6260,9 +7109,130 @@ func init() {
 			BrowserVersion: "",
 			Engine:         "unknown",
 			EngineVersion:  "",
-			OS:             "iPhone OS 7.0",
+			OS:             "iOS 7.0.3",
 			Platform:       "iphone",
 			Mobile:         true,
+			DeviceType:     "phone",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			BrowserName:    "edge",
+			BrowserVersion: "120.0.2210.91",
+			Engine:         "blink",
+			EngineVersion:  "120",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Mobile:         false,
+			DeviceType:     "desktop",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Linux; Android 10; HD1913) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.144 Mobile Safari/537.36 EdgA/120.0.2210.126",
+			BrowserName:    "edge",
+			BrowserVersion: "120.0.2210.126",
+			Engine:         "blink",
+			EngineVersion:  "120",
+			OS:             "Android 10",
+			Platform:       "android",
+			Mobile:         true,
+			DeviceType:     "phone",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0",
+			BrowserName:    "opera",
+			BrowserVersion: "106.0.0.0",
+			Engine:         "blink",
+			EngineVersion:  "120",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Mobile:         false,
+			DeviceType:     "desktop",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Linux; Android 13; SAMSUNG SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			BrowserName:    "samsung",
+			BrowserVersion: "23.0",
+			Engine:         "blink",
+			EngineVersion:  "115",
+			OS:             "Android 13",
+			Platform:       "android",
+			Mobile:         true,
+			DeviceType:     "phone",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 YaBrowser/23.11.0.0 Safari/537.36",
+			BrowserName:    "yandex",
+			BrowserVersion: "23.11.0.0",
+			Engine:         "blink",
+			EngineVersion:  "118",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Mobile:         false,
+			DeviceType:     "desktop",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Vivaldi/6.5.3206.48",
+			BrowserName:    "vivaldi",
+			BrowserVersion: "6.5.3206.48",
+			Engine:         "blink",
+			EngineVersion:  "120",
+			OS:             "macOS 10.15",
+			Platform:       "macintosh",
+			Mobile:         false,
+			DeviceType:     "desktop",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Linux; U; Android 11; en-US; RMX2185 Build/RP1A.201005.001) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/100.0.4896.58 UCBrowser/13.4.2.1307 Mobile Safari/537.36",
+			BrowserName:    "ucbrowser",
+			BrowserVersion: "13.4.2.1307",
+			Engine:         "blink",
+			EngineVersion:  "100",
+			OS:             "Android 11",
+			Platform:       "android",
+			Mobile:         true,
+			DeviceType:     "phone",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Brave Chrome/79.0.3945.130 Safari/537.36",
+			BrowserName:    "brave",
+			BrowserVersion: "79.0.3945.130",
+			Engine:         "blink",
+			EngineVersion:  "79",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Mobile:         false,
+			DeviceType:     "desktop",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19041",
+			BrowserName:    "edge_legacy",
+			BrowserVersion: "18.19041",
+			Engine:         "edgehtml",
+			EngineVersion:  "18.19041",
+			OS:             "Windows 10",
+			Platform:       "windows",
+			Mobile:         false,
+			DeviceType:     "desktop",
+		},
+
+		UserAgentTestCase{
+			UA:             "Mozilla/5.0 (Windows Phone 10.0; Android 6.0.1; Microsoft; Lumia 950) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/52.0.2743.116 Mobile Safari/537.36 Edge/15.15063",
+			BrowserName:    "edge_legacy",
+			BrowserVersion: "15.15063",
+			Engine:         "edgehtml",
+			EngineVersion:  "15.15063",
+			OS:             "Windows Phone",
+			Platform:       "windows_phone",
+			Mobile:         true,
+			DeviceType:     "phone",
 		},
 	}
 }
@@ -0,0 +1,227 @@
+package gopheragent
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version is a browser, engine or OS version split into comparable
+// components. Missing components are 0.
+type Version struct {
+	Major,
+	Minor,
+	Patch,
+	Build int
+	// Extra holds the numeric components following Build, if any, e.g. "5.6"
+	// for 1.2.3.4.5.6
+	Extra      string
+	PreRelease string
+}
+
+// ParseVersion parses versions such as "36.0.1985.143", "11.0b3" or "10_9".
+// Whatever follows the numeric components, e.g. "b3", is the pre-release.
+func ParseVersion(s string) Version {
+
+	var v Version
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch, &v.Build}
+	var extra []string
+
+	for i := 0; s != ""; i++ {
+		n := 0
+		for n < len(s) && '0' <= s[n] && s[n] <= '9' {
+			n++
+		}
+
+		if n == 0 {
+			break
+		}
+
+		if i < len(numbers) {
+			*numbers[i], _ = strconv.Atoi(s[:n])
+		} else {
+			extra = append(extra, strings.TrimLeft(s[:n-1], "0")+s[n-1:n])
+		}
+
+		s = s[n:]
+
+		// a separator not followed by a number starts the pre-release
+		if len(s) < 2 || (s[0] != '.' && s[0] != '_') || s[1] < '0' || s[1] > '9' {
+			break
+		}
+
+		s = s[1:]
+	}
+
+	v.Extra = strings.Join(extra, ".")
+	v.PreRelease = strings.TrimLeft(s, ".-_+~ ")
+
+	return v
+}
+
+// Compare returns -1, 0 or 1 if the version is lower than, equal to or
+// greater than other. Pre-releases are lower than the release they precede.
+func (v Version) Compare(other Version) int {
+
+	a := []int{v.Major, v.Minor, v.Patch, v.Build}
+	b := []int{other.Major, other.Minor, other.Patch, other.Build}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return sign(a[i] - b[i])
+		}
+	}
+
+	if c := compareNumbers(v.Extra, other.Extra); c != 0 {
+		return c
+	}
+
+	switch {
+	case v.PreRelease == other.PreRelease:
+		return 0
+	case v.PreRelease == "":
+		return 1
+	case other.PreRelease == "":
+		return -1
+	}
+
+	return comparePreReleases(v.PreRelease, other.PreRelease)
+}
+
+// compareNumbers compares dot separated numbers such as "5.6", missing
+// numbers being 0
+func compareNumbers(a, b string) int {
+
+	for a != "" || b != "" {
+		var x, y string
+		x, a = splitNumber(a)
+		y, b = splitNumber(b)
+
+		if c := compareDigits(x, y); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// splitNumber returns the first of dot separated numbers and the remaining
+// ones
+func splitNumber(s string) (string, string) {
+
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+
+	return s, ""
+}
+
+// comparePreReleases compares pre-releases such as "beta2" and "beta10" run
+// by run, comparing runs of digits as numbers
+func comparePreReleases(a, b string) int {
+
+	for a != "" && b != "" {
+		var x, y string
+		x, a = splitRun(a)
+		y, b = splitRun(b)
+
+		c := 0
+		if isDigit(x[0]) && isDigit(y[0]) {
+			c = compareDigits(x, y)
+		} else {
+			c = strings.Compare(x, y)
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+// splitRun returns the leading run of digits or non-digits of s and the rest
+func splitRun(s string) (string, string) {
+
+	n := 1
+	for n < len(s) && isDigit(s[n]) == isDigit(s[0]) {
+		n++
+	}
+
+	return s[:n], s[n:]
+}
+
+// compareDigits compares runs of digits as numbers of any length
+func compareDigits(a, b string) int {
+
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+
+	if len(a) != len(b) {
+		return sign(len(a) - len(b))
+	}
+
+	return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// AtLeast returns true if the version is greater than or equal to min, e.g.
+// v.AtLeast("45")
+func (v Version) AtLeast(min string) bool {
+	return v.Compare(ParseVersion(min)) >= 0
+}
+
+// String returns the version with at least its major and minor components,
+// e.g. "10.15" for 10.15.0. ParseVersion parses it back into the same
+// Version.
+func (v Version) String() string {
+
+	s := strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor)
+
+	if v.Patch != 0 || v.Build != 0 || v.Extra != "" {
+		s += "." + strconv.Itoa(v.Patch)
+	}
+
+	if v.Build != 0 || v.Extra != "" {
+		s += "." + strconv.Itoa(v.Build)
+	}
+
+	if v.Extra != "" {
+		s += "." + v.Extra
+	}
+
+	// keep numeric pre-releases apart from the last component
+	if v.PreRelease != "" && isDigit(v.PreRelease[0]) {
+		return s + "-" + v.PreRelease
+	}
+
+	return s + v.PreRelease
+}
+
+// BrowserVersionInfo returns the parsed version of the browser
+func (ua *UserAgent) BrowserVersionInfo() Version {
+	return ParseVersion(ua.BrowserVersion())
+}
+
+// EngineVersionInfo returns the parsed version of the rendering engine
+func (ua *UserAgent) EngineVersionInfo() Version {
+	return ParseVersion(ua.EngineVersion())
+}
+
+// OSVersionInfo returns the parsed version of the operating system
+func (ua *UserAgent) OSVersionInfo() Version {
+	return ParseVersion(ua.OSVersion())
+}
+
+func sign(n int) int {
+
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,118 @@
+package gopheragent_test
+
+import (
+	"testing"
+
+	"github.com/remind101/gopheragent"
+)
+
+func Test_ParseVersion(t *testing.T) {
+
+	tests := map[string]gopheragent.Version{
+		"":               {},
+		"45":             {Major: 45},
+		"36.0.1985.143":  {Major: 36, Minor: 0, Patch: 1985, Build: 143},
+		"11.0b3":         {Major: 11, Minor: 0, PreRelease: "b3"},
+		"3.6.0pre":       {Major: 3, Minor: 6, Patch: 0, PreRelease: "pre"},
+		"10_9":           {Major: 10, Minor: 9},
+		"17.2-beta":      {Major: 17, Minor: 2, PreRelease: "beta"},
+		"1.2.3.4.5":      {Major: 1, Minor: 2, Patch: 3, Build: 4, Extra: "5"},
+		"1.2.3.4.5.06b1": {Major: 1, Minor: 2, Patch: 3, Build: 4, Extra: "5.6", PreRelease: "b1"},
+		"1.0-2":          {Major: 1, PreRelease: "2"},
+	}
+
+	for s, want := range tests {
+		if got := gopheragent.ParseVersion(s); got != want {
+			t.Errorf("ParseVersion[%s] => %+v; want %+v", s, got, want)
+		}
+	}
+}
+
+func Test_Version_Compare(t *testing.T) {
+
+	tests := []struct {
+		A, B string
+		Want int
+	}{
+		{"36.0.1985.143", "36.0.1985.143", 0},
+		{"45", "45.0", 0},
+		{"36.0.1985.143", "36.0.1985.125", 1},
+		{"9.80", "10.0", -1},
+		{"11.0b3", "11.0", -1},
+		{"11.0", "11.0b3", 1},
+		{"11.0b3", "11.0b2", 1},
+		{"11.0beta10", "11.0beta2", 1},
+		{"11.0beta", "11.0beta2", -1},
+		{"11.0alpha2", "11.0beta1", -1},
+		{"1.2.3.4.10", "1.2.3.4.9", 1},
+		{"1.2.3.4.0", "1.2.3.4", 0},
+		{"1.2.3.4.5", "1.2.3.4.5b1", 1},
+	}
+
+	for _, test := range tests {
+		a, b := gopheragent.ParseVersion(test.A), gopheragent.ParseVersion(test.B)
+
+		if got := a.Compare(b); got != test.Want {
+			t.Errorf("Version.Compare[%s, %s] => %d; want %d", test.A, test.B, got, test.Want)
+		}
+	}
+}
+
+func Test_Version_String(t *testing.T) {
+
+	tests := map[string]string{
+		"45":            "45.0",
+		"10.15.0":       "10.15",
+		"36.0.1985.143": "36.0.1985.143",
+		"11.0b3":        "11.0b3",
+		"1.2.3.4.5":     "1.2.3.4.5",
+		"1.0-2":         "1.0-2",
+	}
+
+	for s, want := range tests {
+		if got := gopheragent.ParseVersion(s).String(); got != want {
+			t.Errorf("Version.String[%s] => %s; want %s", s, got, want)
+		}
+	}
+}
+
+func Test_Version_RoundTrip(t *testing.T) {
+
+	tests := []string{
+		"45",
+		"36.0.1985.143",
+		"11.0b3",
+		"17.2-beta",
+		"11.0beta10",
+		"1.2.3.4.5",
+		"1.0.0.0.7.8",
+		"1.2.3.4.5.6rc1",
+		"1.0-2",
+		"10_9_4",
+	}
+
+	for _, s := range tests {
+		v := gopheragent.ParseVersion(s)
+
+		if got := gopheragent.ParseVersion(v.String()); got != v {
+			t.Errorf("ParseVersion[%s] => %+v; want %+v", v, got, v)
+		}
+	}
+}
+
+func Test_UserAgent_VersionInfo(t *testing.T) {
+
+	ua := gopheragent.New("Mozilla/5.0 (Macintosh; Intel Mac OS X 10.9; rv:31.0) Gecko/20100101 Firefox/31.0")
+
+	if got := ua.BrowserVersionInfo(); !got.AtLeast("31") || got.AtLeast("31.1") {
+		t.Errorf("UserAgent.BrowserVersionInfo => %s; want 31.0", got)
+	}
+
+	if got, want := ua.EngineVersionInfo(), (gopheragent.Version{Major: 20100101}); got != want {
+		t.Errorf("UserAgent.EngineVersionInfo => %+v; want %+v", got, want)
+	}
+
+	if got, want := ua.OSVersionInfo(), (gopheragent.Version{Major: 10, Minor: 9}); got != want {
+		t.Errorf("UserAgent.OSVersionInfo => %+v; want %+v", got, want)
+	}
+}